@@ -0,0 +1,742 @@
+package web3
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// SelectorLength is the length of an ABI function selector in bytes.
+const SelectorLength = 4
+
+// WordLength is the length of a single ABI word in bytes.
+const WordLength = 32
+
+var (
+	// maxUint256 is 2^256 - 1, the largest value that fits in an ABI word.
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	// minInt256 is -2^255, the smallest value that fits in an ABI word.
+	minInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	// twoTo256 is 2^256, the modulus used for two's-complement encoding.
+	twoTo256 = new(big.Int).Lsh(big.NewInt(1), 256)
+)
+
+// FunctionSelector computes the 4-byte ABI function selector of a function signature.
+//
+// The selector is the first 4 bytes of the Keccak-256 hash of the canonical signature,
+// e.g. "transfer(address,uint256)" yields 0xa9059cbb. The signature must be given in its
+// canonical form, without parameter names or spaces.
+//
+// Parameters:
+//   - signature: A string containing the canonical function signature.
+//
+// Returns:
+//   - []byte: A byte slice containing the 4-byte function selector.
+func FunctionSelector(signature string) []byte {
+	return Keccak([]byte(signature))[:SelectorLength]
+}
+
+// FunctionSelectorHex computes the ABI function selector of a function signature and
+// returns it as a hexadecimal string.
+//
+// Parameters:
+//   - signature: A string containing the canonical function signature.
+//
+// Returns:
+//   - string: The function selector as a hexadecimal string, including the "0x" prefix.
+func FunctionSelectorHex(signature string) string {
+	return "0x" + hex.EncodeToString(FunctionSelector(signature))
+}
+
+// EventTopic computes the topic of an event signature, i.e. the full 32-byte
+// Keccak-256 hash of the canonical signature, which appears as topics[0] of
+// non-anonymous event logs.
+//
+// Parameters:
+//   - signature: A string containing the canonical event signature,
+//     e.g. "Transfer(address,address,uint256)".
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte event topic.
+func EventTopic(signature string) []byte {
+	return Keccak([]byte(signature))
+}
+
+// EventTopicHex computes the topic of an event signature and returns it as a
+// hexadecimal string.
+//
+// Parameters:
+//   - signature: A string containing the canonical event signature.
+//
+// Returns:
+//   - string: The event topic as a hexadecimal string, including the "0x" prefix.
+func EventTopicHex(signature string) string {
+	return "0x" + hex.EncodeToString(EventTopic(signature))
+}
+
+// LogMatchesEvent reports whether a log was emitted by the given event, i.e. whether its
+// first topic equals the topic of the event signature.
+//
+// Logs without topics, such as those of anonymous events, never match.
+//
+// Parameters:
+//   - topics: The topics of the log, in order.
+//   - signature: A string containing the canonical event signature,
+//     e.g. "Transfer(address,address,uint256)".
+//
+// Returns:
+//   - bool: true if topics[0] is the topic of the event signature, false otherwise.
+func LogMatchesEvent(topics [][]byte, signature string) bool {
+	if len(topics) == 0 {
+		return false
+	}
+
+	return bytes.Equal(topics[0], EventTopic(signature))
+}
+
+// TopicToAddress decodes an indexed address event parameter from a log topic.
+//
+// Indexed addresses are stored as 32-byte topics with the address left-padded with zeros,
+// e.g. topics[1] and topics[2] of an ERC-20 Transfer event.
+//
+// Parameters:
+//   - topic: A byte slice containing the 32-byte log topic.
+//
+// Returns:
+//   - string: The checksummed address taken from the last 20 bytes of the topic.
+//   - error: An error if the topic is not 32 bytes long or its upper 12 bytes are not zero.
+func TopicToAddress(topic []byte) (string, error) {
+	return DecodeAddress(topic)
+}
+
+// BigIntTo32Bytes encodes an integer as a 32-byte big-endian ABI word.
+//
+// Non-negative values are encoded as uint256 and negative values are encoded using
+// two's complement as int256, so the accepted range is [-2^255, 2^256-1].
+//
+// Parameters:
+//   - n: A big.Int containing the value to be encoded.
+//
+// Returns:
+//   - []byte: A byte slice that is exactly 32 bytes long.
+//   - error: An error if the value does not fit in a 32-byte word.
+func BigIntTo32Bytes(n *big.Int) ([]byte, error) {
+	if n.Cmp(maxUint256) > 0 || n.Cmp(minInt256) < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrValueTooLarge, n.String())
+	}
+
+	value := n
+	if n.Sign() < 0 {
+		// Two's complement: 2^256 + n
+		value = new(big.Int).Add(twoTo256, n)
+	}
+
+	return value.FillBytes(make([]byte, WordLength)), nil
+}
+
+// BytesToBigInt decodes a big-endian byte slice, such as a 32-byte ABI word, into an integer.
+//
+// Parameters:
+//   - b: A byte slice containing the big-endian encoded value.
+//   - signed: Whether to interpret the value as a two's-complement signed integer.
+//
+// Returns:
+//   - *big.Int: The decoded value.
+func BytesToBigInt(b []byte, signed bool) *big.Int {
+	n := new(big.Int).SetBytes(b)
+
+	if signed && len(b) > 0 && b[0]&0x80 != 0 {
+		// Negative value: n - 2^(8*len(b))
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+
+	return n
+}
+
+// ParseUint256 parses a base-10 string into a value that is guaranteed to fit in a uint256.
+//
+// Only plain decimal digits are accepted; signs, whitespace, fractions and exponents are
+// rejected, which makes it a safe front door for user-entered amounts.
+//
+// Parameters:
+//   - decimal: A string containing the decimal representation of the value.
+//
+// Returns:
+//   - *big.Int: The parsed value, in the range [0, 2^256-1].
+//   - error: An error if the string is not a plain decimal number or the value exceeds 2^256-1.
+func ParseUint256(decimal string) (*big.Int, error) {
+	if decimal == "" {
+		return nil, fmt.Errorf("invalid uint256 %q: empty", decimal)
+	}
+
+	for _, c := range decimal {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid uint256 %q: unexpected character %q", decimal, c)
+		}
+	}
+
+	n, ok := new(big.Int).SetString(decimal, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 %q", decimal)
+	}
+
+	if n.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrValueTooLarge, decimal)
+	}
+
+	return n, nil
+}
+
+// PackArguments builds ABI calldata from a function selector and already encoded argument words.
+//
+// The result is selector || word0 || word1 || ... . Only statically-sized arguments are
+// supported: each word must already be a 32-byte ABI word (see PadTo32Bytes and
+// BigIntTo32Bytes). Dynamic types such as bytes, string and arrays are not supported.
+//
+// Parameters:
+//   - selector: A byte slice containing the 4-byte function selector.
+//   - words: A slice of 32-byte ABI words, one per argument, in order.
+//
+// Returns:
+//   - []byte: A byte slice containing the packed calldata.
+//   - error: An error if the selector is not 4 bytes or any word is not 32 bytes.
+func PackArguments(selector []byte, words [][]byte) ([]byte, error) {
+	if len(selector) != SelectorLength {
+		return nil, fmt.Errorf("invalid selector length: expected %d bytes, got %d", SelectorLength, len(selector))
+	}
+
+	for i, word := range words {
+		if len(word) != WordLength {
+			return nil, fmt.Errorf("invalid word length at index %d: expected %d bytes, got %d", i, WordLength, len(word))
+		}
+	}
+
+	return ConcatBytes(append([][]byte{selector}, words...)...), nil
+}
+
+// EncodeCall builds ABI calldata for a function call from its signature and Go arguments.
+//
+// The selector is computed with FunctionSelector and each argument is encoded as a
+// 32-byte word according to the parameter type declared in the signature:
+//   - address: Address, or a 20-byte []byte, left-padded
+//   - bool: bool
+//   - uint8 to uint256, int8 to int256: *big.Int, uint8, uint16, uint32, uint64, int or
+//     int64, range-checked against the declared width; negative values are only accepted
+//     for intN and are encoded in two's complement
+//   - bytes1 to bytes32: a []byte of exactly N bytes, or a Hash for bytes32, right-padded
+//
+// Only statically-sized parameter types are supported. Tuples, arrays, bytes and string
+// parameters are rejected, as are the non-canonical aliases uint and int.
+//
+// Parameters:
+//   - signature: The canonical function signature, e.g. "transfer(address,uint256)".
+//   - args: A variadic parameter of arguments of the supported Go types, one per parameter.
+//
+// Returns:
+//   - []byte: A byte slice containing the selector followed by the encoded arguments.
+//   - error: An error if the signature is malformed or has unsupported parameters, the number
+//     of arguments does not match, or an argument does not fit its parameter type.
+func EncodeCall(signature string, args ...interface{}) ([]byte, error) {
+	start := strings.IndexByte(signature, '(')
+	if start < 1 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("invalid function signature %q", signature)
+	}
+
+	var params []string
+	if list := signature[start+1 : len(signature)-1]; list != "" {
+		params = strings.Split(list, ",")
+	}
+
+	for _, param := range params {
+		if strings.ContainsAny(param, "()[]") {
+			return nil, fmt.Errorf("unsupported parameter type %q in %q: only static types are supported", param, signature)
+		}
+	}
+
+	if len(args) != len(params) {
+		return nil, fmt.Errorf("argument count mismatch for %q: expected %d, got %d", signature, len(params), len(args))
+	}
+
+	words := make([][]byte, len(args))
+	for i, param := range params {
+		word, err := encodeCallArgument(param, args[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument at index %d (%s): %w", i, param, err)
+		}
+		words[i] = word
+	}
+
+	return PackArguments(FunctionSelector(signature), words)
+}
+
+// encodeCallArgument encodes a single EncodeCall argument as a word of the given static parameter type.
+func encodeCallArgument(param string, arg interface{}) ([]byte, error) {
+	switch {
+	case param == "address":
+		switch v := arg.(type) {
+		case Address:
+			return PadTo32Bytes(v.Bytes()), nil
+		case []byte:
+			return AddressToWord(v)
+		}
+	case param == "bool":
+		if v, ok := arg.(bool); ok {
+			return BoolToWord(v), nil
+		}
+	case strings.HasPrefix(param, "uint"), strings.HasPrefix(param, "int"):
+		signed := strings.HasPrefix(param, "int")
+		bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(param, "u"), "int"))
+		if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("unsupported parameter type")
+		}
+
+		value, ok := integerArgument(arg)
+		if !ok {
+			break
+		}
+
+		// uintN holds [0, 2^N), intN holds [-2^(N-1), 2^(N-1))
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+		lower := new(big.Int)
+		if signed {
+			limit.Rsh(limit, 1)
+			lower.Neg(limit)
+		}
+		if value.Cmp(lower) < 0 || value.Cmp(limit) >= 0 {
+			return nil, fmt.Errorf("value %s out of range", value)
+		}
+
+		return BigIntTo32Bytes(value)
+	case strings.HasPrefix(param, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(param, "bytes"))
+		if err != nil || size < 1 || size > WordLength {
+			return nil, fmt.Errorf("unsupported parameter type")
+		}
+
+		var data []byte
+		switch v := arg.(type) {
+		case Hash:
+			data = v.Bytes()
+		case []byte:
+			data = v
+		default:
+			return nil, fmt.Errorf("unsupported argument type %T", arg)
+		}
+
+		if len(data) != size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", size, len(data))
+		}
+
+		return BytesNToWord(data)
+	default:
+		return nil, fmt.Errorf("unsupported parameter type")
+	}
+
+	return nil, fmt.Errorf("unsupported argument type %T", arg)
+}
+
+// integerArgument converts a Go integer argument of EncodeCall to a big.Int.
+func integerArgument(arg interface{}) (*big.Int, bool) {
+	switch v := arg.(type) {
+	case *big.Int:
+		return v, v != nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint64:
+		return new(big.Int).SetUint64(v), true
+	case int:
+		return big.NewInt(int64(v)), true
+	case int64:
+		return big.NewInt(v), true
+	default:
+		return nil, false
+	}
+}
+
+// MatchSelector returns the candidate function signatures whose selector matches the
+// given 4-byte selector, acting as a small local 4byte directory.
+//
+// Parameters:
+//   - selector: A byte slice whose first 4 bytes are compared, e.g. the start of calldata.
+//   - candidates: A slice of canonical function signatures to search.
+//
+// Returns:
+//   - []string: The matching signatures, in the order they appear in candidates.
+func MatchSelector(selector []byte, candidates []string) []string {
+	if len(selector) < SelectorLength {
+		return nil
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if bytes.Equal(FunctionSelector(candidate), selector[:SelectorLength]) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	return matches
+}
+
+// EncodeBytesABI encodes a dynamic bytes value as it appears in the tail of ABI encoded data.
+//
+// The result is a 32-byte length word followed by the data right-padded with zero bytes
+// to a multiple of 32 bytes. The offset word pointing to this tail is not included.
+//
+// Parameters:
+//   - data: A byte slice containing the value to be encoded.
+//
+// Returns:
+//   - []byte: A byte slice containing the length word and the padded data.
+func EncodeBytesABI(data []byte) []byte {
+	paddedLength := (len(data) + WordLength - 1) / WordLength * WordLength
+
+	return ConcatBytes(uint64ToWord(uint64(len(data))), PadRight(data, paddedLength))
+}
+
+// EncodeStringABI encodes a dynamic string value as it appears in the tail of ABI encoded
+// data, using its UTF-8 bytes as EncodeBytesABI does.
+//
+// Parameters:
+//   - s: The string to be encoded.
+//
+// Returns:
+//   - []byte: A byte slice containing the length word and the padded string bytes.
+func EncodeStringABI(s string) []byte {
+	return EncodeBytesABI([]byte(s))
+}
+
+// EncodeUint256Array encodes a dynamic uint256[] value as it appears in the tail of ABI
+// encoded data.
+//
+// The result is a 32-byte length word followed by one 32-byte word per element. The offset
+// word pointing to this tail is not included.
+//
+// Parameters:
+//   - values: The array elements, each in the range [0, 2^256-1].
+//
+// Returns:
+//   - []byte: A byte slice containing the length word and the element words.
+//   - error: An error if an element is negative or does not fit in 32 bytes.
+func EncodeUint256Array(values []*big.Int) ([]byte, error) {
+	words := make([][]byte, 0, len(values)+1)
+	words = append(words, uint64ToWord(uint64(len(values))))
+
+	for i, value := range values {
+		if value.Sign() < 0 {
+			return nil, fmt.Errorf("element at index %d: negative value %s", i, value)
+		}
+
+		word, err := BigIntTo32Bytes(value)
+		if err != nil {
+			return nil, fmt.Errorf("element at index %d: %w", i, err)
+		}
+		words = append(words, word)
+	}
+
+	return ConcatBytes(words...), nil
+}
+
+// EncodeAddressArray encodes a dynamic address[] value as it appears in the tail of ABI
+// encoded data.
+//
+// The result is a 32-byte length word followed by each address left-padded to a 32-byte
+// word. The offset word pointing to this tail is not included.
+//
+// Parameters:
+//   - addrs: The array elements, each a 20-byte address.
+//
+// Returns:
+//   - []byte: A byte slice containing the length word and the address words.
+//   - error: An error if an address is not 20 bytes long.
+func EncodeAddressArray(addrs [][]byte) ([]byte, error) {
+	words := make([][]byte, 0, len(addrs)+1)
+	words = append(words, uint64ToWord(uint64(len(addrs))))
+
+	for i, addr := range addrs {
+		word, err := AddressToWord(addr)
+		if err != nil {
+			return nil, fmt.Errorf("element at index %d: %w", i, err)
+		}
+		words = append(words, word)
+	}
+
+	return ConcatBytes(words...), nil
+}
+
+// uint64ToWord encodes an unsigned integer as a 32-byte big-endian ABI word.
+func uint64ToWord(n uint64) []byte {
+	return PadTo32Bytes(binary.BigEndian.AppendUint64(nil, n))
+}
+
+// AddressToWord encodes an address as a 32-byte ABI word, left-padded with zeros.
+//
+// Parameters:
+//   - address: A byte slice containing the 20-byte address.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte ABI word.
+//   - error: An error if the address is not 20 bytes long.
+func AddressToWord(address []byte) ([]byte, error) {
+	if len(address) != AddressLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, AddressLength, len(address))
+	}
+
+	return PadTo32Bytes(address), nil
+}
+
+// WordToAddress decodes a 32-byte ABI word holding a left-padded address, the reverse
+// of AddressToWord. It is equivalent to DecodeAddress.
+//
+// Parameters:
+//   - word: A byte slice containing the 32-byte ABI word.
+//
+// Returns:
+//   - string: The checksummed address taken from the last 20 bytes of the word.
+//   - error: An error if the word is not 32 bytes long or its upper 12 bytes are not zero.
+func WordToAddress(word []byte) (string, error) {
+	return DecodeAddress(word)
+}
+
+// BoolToWord encodes a bool as a 32-byte ABI word, all zero except for a last byte of 1 for true.
+//
+// Parameters:
+//   - b: The bool value to be encoded.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte ABI word.
+func BoolToWord(b bool) []byte {
+	word := make([]byte, WordLength)
+	if b {
+		word[WordLength-1] = 1
+	}
+
+	return word
+}
+
+// BytesNToWord encodes a fixed-size bytes1 to bytes32 value as a 32-byte ABI word.
+//
+// Unlike integers and addresses, which are left-padded, fixed-size byte arrays are
+// right-padded with zeros, e.g. bytes4(0x12345678) is encoded as 0x12345678 followed
+// by 28 zero bytes.
+//
+// Parameters:
+//   - data: A byte slice containing at most 32 bytes.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte ABI word.
+//   - error: An error if the data is longer than 32 bytes.
+func BytesNToWord(data []byte) ([]byte, error) {
+	if len(data) > WordLength {
+		return nil, fmt.Errorf("%w: got %d", ErrValueTooLarge, len(data))
+	}
+
+	word := make([]byte, WordLength)
+	copy(word, data)
+
+	return word, nil
+}
+
+// DecodeUint256 decodes a 32-byte ABI word as an unsigned uint256 value.
+//
+// Parameters:
+//   - word: A byte slice containing the big-endian ABI word.
+//
+// Returns:
+//   - *big.Int: The decoded non-negative value.
+func DecodeUint256(word []byte) *big.Int {
+	return BytesToBigInt(word, false)
+}
+
+// DecodeAddress decodes a 32-byte ABI word holding a left-padded address.
+//
+// Parameters:
+//   - word: A byte slice containing the 32-byte ABI word.
+//
+// Returns:
+//   - string: The checksummed address taken from the last 20 bytes of the word.
+//   - error: An error if the word is not 32 bytes long or its upper 12 bytes are not zero.
+func DecodeAddress(word []byte) (string, error) {
+	if len(word) != WordLength {
+		return "", fmt.Errorf("invalid word length: expected %d bytes, got %d", WordLength, len(word))
+	}
+
+	if !bytes.Equal(word[:WordLength-AddressLength], make([]byte, WordLength-AddressLength)) {
+		return "", fmt.Errorf("invalid address word: non-zero padding")
+	}
+
+	return ToChecksumAddress(word[WordLength-AddressLength:])
+}
+
+// DecodeBool decodes a 32-byte ABI word as a bool.
+//
+// Parameters:
+//   - word: A byte slice containing the ABI word.
+//
+// Returns:
+//   - bool: true if any byte of the word is non-zero, false otherwise.
+func DecodeBool(word []byte) bool {
+	for _, b := range word {
+		if b != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WordReader reads consecutive 32-byte ABI words from encoded data, such as the return
+// data of eth_call.
+type WordReader struct {
+	data []byte
+	pos  int
+}
+
+// NewWordReader creates a WordReader reading from the given ABI encoded data.
+//
+// Parameters:
+//   - data: A byte slice containing the ABI encoded data, without a function selector.
+//
+// Returns:
+//   - *WordReader: A reader positioned at the first word.
+func NewWordReader(data []byte) *WordReader {
+	return &WordReader{data: data}
+}
+
+// ReadWord returns the next 32-byte word and advances the reader.
+//
+// Returns:
+//   - []byte: A byte slice containing the next 32-byte word.
+//   - error: io.EOF if no data is left, or io.ErrUnexpectedEOF if fewer than 32 bytes remain.
+func (r *WordReader) ReadWord() ([]byte, error) {
+	remaining := len(r.data) - r.pos
+
+	if remaining == 0 {
+		return nil, io.EOF
+	}
+
+	if remaining < WordLength {
+		return nil, fmt.Errorf("%w: %d bytes left, expected %d", io.ErrUnexpectedEOF, remaining, WordLength)
+	}
+
+	word := r.data[r.pos : r.pos+WordLength]
+	r.pos += WordLength
+
+	return word, nil
+}
+
+// Remaining returns the number of unread bytes.
+func (r *WordReader) Remaining() int {
+	return len(r.data) - r.pos
+}
+
+// EncodePacked encodes values using Solidity's non-standard packed mode, matching
+// abi.encodePacked(...), and returns their concatenation.
+//
+// Each value is encoded in the minimal width of its Solidity type, without padding:
+//   - Address: 20 bytes (address)
+//   - Hash: 32 bytes (bytes32)
+//   - *big.Int: 32 bytes (uint256, or int256 in two's complement if negative)
+//   - uint8, uint16, uint32, uint64: 1, 2, 4 and 8 bytes big-endian
+//   - bool: 1 byte, 0x01 or 0x00
+//   - string, []byte: raw bytes (string, bytes)
+//
+// Parameters:
+//   - values: A variadic parameter of values of the supported Go types.
+//
+// Returns:
+//   - []byte: A byte slice containing the packed encoding.
+//   - error: An error if a value has an unsupported type or does not fit in 32 bytes.
+func EncodePacked(values ...interface{}) ([]byte, error) {
+	encoded := make([][]byte, len(values))
+
+	for i, value := range values {
+		switch v := value.(type) {
+		case Address:
+			encoded[i] = v.Bytes()
+		case Hash:
+			encoded[i] = v.Bytes()
+		case *big.Int:
+			word, err := BigIntTo32Bytes(v)
+			if err != nil {
+				return nil, fmt.Errorf("value at index %d: %w", i, err)
+			}
+			encoded[i] = word
+		case uint8:
+			encoded[i] = []byte{v}
+		case uint16:
+			encoded[i] = binary.BigEndian.AppendUint16(nil, v)
+		case uint32:
+			encoded[i] = binary.BigEndian.AppendUint32(nil, v)
+		case uint64:
+			encoded[i] = binary.BigEndian.AppendUint64(nil, v)
+		case bool:
+			if v {
+				encoded[i] = []byte{1}
+			} else {
+				encoded[i] = []byte{0}
+			}
+		case string:
+			encoded[i] = []byte(v)
+		case []byte:
+			encoded[i] = v
+		default:
+			return nil, fmt.Errorf("value at index %d: unsupported type %T", i, value)
+		}
+	}
+
+	return ConcatBytes(encoded...), nil
+}
+
+// MulticallCall is a single call aggregated by EncodeMulticall.
+type MulticallCall struct {
+	// Target is the 20-byte address of the contract to call.
+	Target []byte
+	// Data is the calldata of the call, including its function selector.
+	Data []byte
+}
+
+// EncodeMulticall encodes calldata for the Multicall aggregate((address,bytes)[]) function,
+// which executes several calls in a single eth_call.
+//
+// Parameters:
+//   - calls: The calls to aggregate, in execution order.
+//
+// Returns:
+//   - []byte: A byte slice containing the selector and the ABI encoded call array.
+//   - error: An error if a target address is not 20 bytes long.
+func EncodeMulticall(calls []MulticallCall) ([]byte, error) {
+	// Each (address,bytes) tuple is dynamic, so the array holds one offset per tuple,
+	// relative to the start of the offsets, followed by the tuple encodings
+	offsets := make([][]byte, len(calls))
+	tuples := make([][]byte, len(calls))
+
+	offset := len(calls) * WordLength
+	for i, call := range calls {
+		if len(call.Target) != AddressLength {
+			return nil, fmt.Errorf("call at index %d: %w: expected %d bytes, got %d", i, ErrInvalidAddressLength, AddressLength, len(call.Target))
+		}
+
+		// The bytes member follows the address word and its own offset word
+		tuples[i] = ConcatBytes(PadTo32Bytes(call.Target), uint64ToWord(2*WordLength), EncodeBytesABI(call.Data))
+
+		offsets[i] = uint64ToWord(uint64(offset))
+		offset += len(tuples[i])
+	}
+
+	return ConcatBytes(
+		FunctionSelector("aggregate((address,bytes)[])"),
+		uint64ToWord(WordLength), // offset of the array argument
+		uint64ToWord(uint64(len(calls))),
+		ConcatBytes(offsets...),
+		ConcatBytes(tuples...),
+	), nil
+}
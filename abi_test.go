@@ -0,0 +1,139 @@
+package web3
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEncodeCall(t *testing.T) {
+	recipient, _ := hex.DecodeString("2c7536e3605d9c16a7a3d7b1898e529396a65c23")
+	maxUint256, _ := new(big.Int).SetString("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
+
+	tests := []struct {
+		name      string
+		signature string
+		args      []interface{}
+		want      string
+	}{
+		{
+			name:      "no arguments",
+			signature: "totalSupply()",
+			want:      "0x18160ddd",
+		},
+		{
+			name:      "address and uint256",
+			signature: "transfer(address,uint256)",
+			args:      []interface{}{recipient, big.NewInt(1000)},
+			want: "0xa9059cbb" +
+				"0000000000000000000000002c7536e3605d9c16a7a3d7b1898e529396a65c23" +
+				"00000000000000000000000000000000000000000000000000000000000003e8",
+		},
+		{
+			name:      "Address type",
+			signature: "balanceOf(address)",
+			args:      []interface{}{Address(*(*[20]byte)(recipient))},
+			want:      "0x70a08231" + "0000000000000000000000002c7536e3605d9c16a7a3d7b1898e529396a65c23",
+		},
+		{
+			name:      "maximum uint256",
+			signature: "approve(address,uint256)",
+			args:      []interface{}{recipient, maxUint256},
+			want: "0x095ea7b3" +
+				"0000000000000000000000002c7536e3605d9c16a7a3d7b1898e529396a65c23" +
+				"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		},
+		{
+			name:      "negative int256",
+			signature: "f(int256)",
+			args:      []interface{}{big.NewInt(-1)},
+			want:      "0x" + hex.EncodeToString(FunctionSelector("f(int256)")) + strings.Repeat("ff", 32),
+		},
+		{
+			name:      "uint8 at its maximum",
+			signature: "f(uint8)",
+			args:      []interface{}{uint64(255)},
+			want:      "0x" + hex.EncodeToString(FunctionSelector("f(uint8)")) + strings.Repeat("00", 31) + "ff",
+		},
+		{
+			name:      "int8 at its minimum",
+			signature: "f(int8)",
+			args:      []interface{}{-128},
+			want:      "0x" + hex.EncodeToString(FunctionSelector("f(int8)")) + strings.Repeat("ff", 31) + "80",
+		},
+		{
+			name:      "bool",
+			signature: "setApprovalForAll(address,bool)",
+			args:      []interface{}{recipient, true},
+			want: "0xa22cb465" +
+				"0000000000000000000000002c7536e3605d9c16a7a3d7b1898e529396a65c23" +
+				"0000000000000000000000000000000000000000000000000000000000000001",
+		},
+		{
+			name:      "bytes4 is right-padded",
+			signature: "supportsInterface(bytes4)",
+			args:      []interface{}{[]byte{0x01, 0xff, 0xc9, 0xa7}},
+			want:      "0x01ffc9a7" + "01ffc9a7" + strings.Repeat("00", 28),
+		},
+		{
+			name:      "bytes32 from Hash",
+			signature: "f(bytes32)",
+			args:      []interface{}{EmptyKeccak256},
+			want:      "0x" + hex.EncodeToString(FunctionSelector("f(bytes32)")) + hex.EncodeToString(EmptyKeccak256.Bytes()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeCall(tt.signature, tt.args...)
+			if err != nil {
+				t.Fatalf("EncodeCall(%q) returned error: %v", tt.signature, err)
+			}
+
+			if BytesToHex(got) != tt.want {
+				t.Errorf("EncodeCall(%q) = %s, want %s", tt.signature, BytesToHex(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeCallErrors(t *testing.T) {
+	recipient := make([]byte, 20)
+	word := make([]byte, 32)
+
+	tests := []struct {
+		name      string
+		signature string
+		args      []interface{}
+	}{
+		{"malformed signature", "transfer", nil},
+		{"too few arguments", "f(uint256)", nil},
+		{"too many arguments", "f()", []interface{}{uint64(1)}},
+		{"integer for address", "transfer(address,uint256)", []interface{}{uint8(1), big.NewInt(1)}},
+		{"bool for uint256", "transfer(address,uint256)", []interface{}{recipient, true}},
+		{"uint8 overflow", "f(uint8)", []interface{}{big.NewInt(1000)}},
+		{"int8 overflow", "f(int8)", []interface{}{big.NewInt(128)}},
+		{"int8 underflow", "f(int8)", []interface{}{big.NewInt(-129)}},
+		{"negative uint256", "f(uint256)", []interface{}{big.NewInt(-1)}},
+		{"nil big.Int", "f(uint256)", []interface{}{(*big.Int)(nil)}},
+		{"short address", "f(address)", []interface{}{recipient[:19]}},
+		{"wrong bytesN length", "f(bytes4)", []interface{}{[]byte{1, 2, 3}}},
+		{"Hash for bytes4", "f(bytes4)", []interface{}{Hash{}}},
+		{"invalid integer width", "f(uint7)", []interface{}{uint8(1)}},
+		{"non-canonical uint", "f(uint)", []interface{}{uint8(1)}},
+		{"bytes33", "f(bytes33)", []interface{}{word}},
+		{"dynamic bytes", "f(bytes)", []interface{}{word}},
+		{"string", "f(string)", []interface{}{"hello"}},
+		{"array", "f(uint256[])", []interface{}{big.NewInt(1)}},
+		{"tuple", "f((address,uint256))", []interface{}{recipient}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := EncodeCall(tt.signature, tt.args...); err == nil {
+				t.Errorf("EncodeCall(%q) = %s, want error", tt.signature, BytesToHex(got))
+			}
+		})
+	}
+}
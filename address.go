@@ -0,0 +1,206 @@
+package web3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Address represents a 20-byte Ethereum address.
+//
+// Being a fixed-size array, an Address can be compared with == and used as a map key.
+type Address [AddressLength]byte
+
+// HexToAddress parses a hexadecimal Ethereum address string into an Address.
+//
+// The string is parsed with ParseAddress, so surrounding whitespace, an optional "0x"
+// prefix and any letter case are accepted.
+//
+// Parameters:
+//   - s: A string containing the hexadecimal representation of an Ethereum address.
+//
+// Returns:
+//   - Address: The parsed address.
+//   - error: An error if the string is not valid hexadecimal or does not decode to 20 bytes.
+func HexToAddress(s string) (Address, error) {
+	var address Address
+
+	parsed, err := ParseAddress(s)
+	if err != nil {
+		return address, err
+	}
+
+	copy(address[:], parsed)
+
+	return address, nil
+}
+
+// Hex returns the EIP-55 checksummed representation of the address, including the "0x" prefix.
+func (a Address) Hex() string {
+	// The length is always valid, so ToChecksumAddress cannot fail
+	checksumAddress, _ := ToChecksumAddress(a[:])
+
+	return checksumAddress
+}
+
+// String implements fmt.Stringer and returns the same value as Hex.
+func (a Address) String() string {
+	return a.Hex()
+}
+
+// Bytes returns the address as a byte slice.
+func (a Address) Bytes() []byte {
+	return a[:]
+}
+
+// IsZero reports whether the address is the zero address (0x000...0).
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// MarshalJSON implements json.Marshaler and encodes the address as its EIP-55
+// checksummed hexadecimal string.
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Hex())
+}
+
+// UnmarshalJSON implements json.Unmarshaler and decodes the address from a hexadecimal
+// string in any letter case, with or without the "0x" prefix. Following the encoding/json
+// convention, a JSON null leaves the address unchanged.
+func (a *Address) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid address: %v", err)
+	}
+
+	address, err := HexToAddress(s)
+	if err != nil {
+		return err
+	}
+
+	*a = address
+
+	return nil
+}
+
+// ContractAddress computes the address of a contract deployed with CREATE.
+//
+// The address is the last 20 bytes of keccak256(rlp([deployer, nonce])), where nonce is
+// the transaction nonce of the deploying account (or the contract nonce of a deploying
+// contract).
+//
+// Parameters:
+//   - deployer: A byte slice containing the 20-byte address of the deploying account.
+//   - nonce: The nonce of the deploying account at the time of deployment.
+//
+// Returns:
+//   - string: The checksummed contract address, including the "0x" prefix.
+//   - error: An error if the deployer address is not 20 bytes long.
+func ContractAddress(deployer []byte, nonce uint64) (string, error) {
+	if len(deployer) != AddressLength {
+		return "", fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, AddressLength, len(deployer))
+	}
+
+	addressHash := Keccak(EncodeRLPList(EncodeRLPBytes(deployer), EncodeRLPUint(nonce)))
+
+	return ToChecksumAddress(addressHash[12:])
+}
+
+// Create2Address computes the address of a contract deployed with CREATE2 (EIP-1014).
+//
+// The address is the last 20 bytes of keccak256(0xff || deployer || salt || initCodeHash).
+//
+// Parameters:
+//   - deployer: A byte slice containing the 20-byte address of the deploying contract.
+//   - salt: A byte slice containing the 32-byte salt.
+//   - initCodeHash: A byte slice containing the 32-byte Keccak-256 hash of the init code.
+//
+// Returns:
+//   - string: The checksummed contract address, including the "0x" prefix.
+//   - error: An error if the deployer is not 20 bytes or the salt or hash is not 32 bytes long.
+func Create2Address(deployer []byte, salt []byte, initCodeHash []byte) (string, error) {
+	if len(deployer) != AddressLength {
+		return "", fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, AddressLength, len(deployer))
+	}
+
+	if len(salt) != HashLength {
+		return "", fmt.Errorf("invalid salt length: expected %d bytes, got %d", HashLength, len(salt))
+	}
+
+	if len(initCodeHash) != HashLength {
+		return "", fmt.Errorf("invalid init code hash length: expected %d bytes, got %d", HashLength, len(initCodeHash))
+	}
+
+	addressHash := Keccak(ConcatBytes([]byte{0xff}, deployer, salt, initCodeHash))
+
+	return ToChecksumAddress(addressHash[12:])
+}
+
+// ParseChainPrefixedAddress parses an EIP-3770 chain-specific address such as "eth:0xAbC...".
+//
+// Parameters:
+//   - s: A string of the form "<shortName>:<address>".
+//
+// Returns:
+//   - chainShortName: The chain short name, e.g. "eth" or "arb1".
+//   - address: The EIP-55 checksummed address.
+//   - err: An error if the string has no chain prefix, the short name contains invalid
+//     characters or the address is not correctly checksummed.
+func ParseChainPrefixedAddress(s string) (chainShortName string, address string, err error) {
+	chainShortName, address, found := strings.Cut(s, ":")
+	if !found {
+		return "", "", fmt.Errorf("invalid chain-prefixed address %q: missing chain short name", s)
+	}
+
+	if err := validateChainShortName(chainShortName); err != nil {
+		return "", "", err
+	}
+
+	if !IsChecksumAddress(address) {
+		return "", "", fmt.Errorf("invalid chain-prefixed address %q: address is not checksummed", s)
+	}
+
+	return chainShortName, address, nil
+}
+
+// FormatChainPrefixedAddress formats an address as an EIP-3770 chain-specific address.
+//
+// Parameters:
+//   - chainShortName: The chain short name, e.g. "eth" or "arb1".
+//   - address: The EIP-55 checksummed address.
+//
+// Returns:
+//   - string: The chain-prefixed address, "<shortName>:<address>".
+//   - error: An error if the short name contains invalid characters or the address is not
+//     correctly checksummed.
+func FormatChainPrefixedAddress(chainShortName string, address string) (string, error) {
+	if err := validateChainShortName(chainShortName); err != nil {
+		return "", err
+	}
+
+	if !IsChecksumAddress(address) {
+		return "", fmt.Errorf("invalid address %q: address is not checksummed", address)
+	}
+
+	return chainShortName + ":" + address, nil
+}
+
+// validateChainShortName checks that a chain short name is non-empty and only contains
+// ASCII letters, digits and dashes.
+func validateChainShortName(chainShortName string) error {
+	if chainShortName == "" {
+		return fmt.Errorf("invalid chain short name: empty")
+	}
+
+	for _, c := range chainShortName {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+			return fmt.Errorf("invalid chain short name %q", chainShortName)
+		}
+	}
+
+	return nil
+}
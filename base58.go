@@ -0,0 +1,118 @@
+package web3
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// base58Alphabet is the Bitcoin Base58 alphabet, which omits 0, O, I and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Base58Encode encodes a byte slice using the Bitcoin Base58 alphabet.
+//
+// Each leading zero byte is encoded as a leading '1' character.
+//
+// Parameters:
+//   - b: A byte slice containing the data to be encoded.
+//
+// Returns:
+//   - string: The Base58 representation of the data.
+func Base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var encoded []byte
+	for n.Sign() > 0 {
+		n.QuoRem(n, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	// Digits were produced least significant first
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+// Base58Decode decodes a string encoded with the Bitcoin Base58 alphabet.
+//
+// Parameters:
+//   - s: A string containing the Base58 encoded data.
+//
+// Returns:
+//   - []byte: A byte slice containing the decoded data.
+//   - error: An error if the string contains characters outside the Base58 alphabet.
+func Base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(base58Alphabet, s[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("%w: invalid character %q at position %d", ErrInvalidBase58, s[i], i)
+		}
+
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	return ConcatBytes(make([]byte, zeros), n.Bytes()), nil
+}
+
+// Base58CheckEncode encodes a payload using Base58Check, appending the first 4 bytes of
+// its DoubleSHA256 hash as a checksum before Base58 encoding.
+//
+// Any version byte must already be included at the start of the payload.
+//
+// Parameters:
+//   - payload: A byte slice containing the data to be encoded.
+//
+// Returns:
+//   - string: The Base58Check representation of the payload.
+func Base58CheckEncode(payload []byte) string {
+	checksum := DoubleSHA256(payload)[:4]
+
+	return Base58Encode(ConcatBytes(payload, checksum))
+}
+
+// Base58CheckDecode decodes a Base58Check string and verifies its 4-byte checksum.
+//
+// Parameters:
+//   - s: A string containing the Base58Check encoded data.
+//
+// Returns:
+//   - []byte: A byte slice containing the payload, without the checksum.
+//   - error: An error if the string is not valid Base58, is too short or the checksum does not match.
+func Base58CheckDecode(s string) ([]byte, error) {
+	decoded, err := Base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) < 4 {
+		return nil, fmt.Errorf("%w: too short for a checksum", ErrInvalidBase58)
+	}
+
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	if !bytes.Equal(DoubleSHA256(payload)[:4], checksum) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidBase58)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,184 @@
+package web3
+
+import (
+	"fmt"
+	"strings"
+)
+
+// bech32Charset is the BIP-173 alphabet mapping 5-bit values to characters.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// bech32Generator holds the BCH code generator coefficients used by bech32Polymod.
+var bech32Generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// Bech32Encode encodes data with a human-readable part using Bech32 (BIP-173).
+//
+// The data is regrouped from 8-bit bytes into 5-bit values before encoding, and a
+// 6-character checksum is appended. The result is always lowercase.
+//
+// Parameters:
+//   - hrp: The human-readable part, e.g. "bc" or "cosmos".
+//   - data: A byte slice containing the payload to be encoded.
+//
+// Returns:
+//   - string: The Bech32 encoded string, hrp + "1" + data + checksum.
+//   - error: An error if the human-readable part is invalid or the result exceeds 90 characters.
+func Bech32Encode(hrp string, data []byte) (string, error) {
+	if err := validateBech32HRP(hrp); err != nil {
+		return "", err
+	}
+
+	hrp = strings.ToLower(hrp)
+	values := convertBits(data, 8, 5, true)
+	values = ConcatBytes(values, bech32Checksum(hrp, values))
+
+	if len(hrp)+1+len(values) > 90 {
+		return "", fmt.Errorf("%w: exceeds 90 characters", ErrInvalidBech32)
+	}
+
+	var encoded strings.Builder
+	encoded.WriteString(hrp)
+	encoded.WriteByte('1')
+	for _, v := range values {
+		encoded.WriteByte(bech32Charset[v])
+	}
+
+	return encoded.String(), nil
+}
+
+// Bech32Decode decodes a Bech32 (BIP-173) string and verifies its checksum.
+//
+// Parameters:
+//   - s: A string containing the Bech32 encoded data, in either all lowercase or all uppercase.
+//
+// Returns:
+//   - hrp: The lowercase human-readable part.
+//   - data: A byte slice containing the payload, regrouped into 8-bit bytes.
+//   - err: An error if the string is malformed, mixes cases or the checksum does not match.
+func Bech32Decode(s string) (hrp string, data []byte, err error) {
+	if len(s) > 90 {
+		return "", nil, fmt.Errorf("%w: exceeds 90 characters", ErrInvalidBech32)
+	}
+
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, fmt.Errorf("%w: mixed case", ErrInvalidBech32)
+	}
+	s = strings.ToLower(s)
+
+	separator := strings.LastIndexByte(s, '1')
+	if separator < 1 || separator+7 > len(s) {
+		return "", nil, fmt.Errorf("%w: invalid separator position", ErrInvalidBech32)
+	}
+
+	hrp = s[:separator]
+	if err := validateBech32HRP(hrp); err != nil {
+		return "", nil, err
+	}
+
+	values := make([]byte, 0, len(s)-separator-1)
+	for i := separator + 1; i < len(s); i++ {
+		v := strings.IndexByte(bech32Charset, s[i])
+		if v < 0 {
+			return "", nil, fmt.Errorf("%w: invalid character %q at position %d", ErrInvalidBech32, s[i], i)
+		}
+		values = append(values, byte(v))
+	}
+
+	if bech32Polymod(ConcatBytes(bech32ExpandHRP(hrp), values)) != 1 {
+		return "", nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidBech32)
+	}
+
+	// Drop the 6-value checksum and regroup into bytes
+	values = values[:len(values)-6]
+	data = convertBits(values, 5, 8, false)
+	if data == nil {
+		return "", nil, fmt.Errorf("%w: invalid padding", ErrInvalidBech32)
+	}
+
+	return hrp, data, nil
+}
+
+// validateBech32HRP checks that a human-readable part has 1 to 83 printable ASCII characters.
+func validateBech32HRP(hrp string) error {
+	if len(hrp) < 1 || len(hrp) > 83 {
+		return fmt.Errorf("%w: human-readable part must be 1 to 83 characters", ErrInvalidBech32)
+	}
+
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return fmt.Errorf("%w: invalid human-readable part character %q", ErrInvalidBech32, hrp[i])
+		}
+	}
+
+	return nil
+}
+
+// bech32Polymod computes the BIP-173 checksum polynomial over 5-bit values.
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+// bech32ExpandHRP expands a human-readable part into the values fed to bech32Polymod.
+func bech32ExpandHRP(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+
+	return expanded
+}
+
+// bech32Checksum computes the 6 checksum values for a human-readable part and 5-bit data.
+func bech32Checksum(hrp string, values []byte) []byte {
+	polymod := bech32Polymod(ConcatBytes(bech32ExpandHRP(hrp), values, make([]byte, 6))) ^ 1
+
+	checksum := make([]byte, 6)
+	for i := range checksum {
+		checksum[i] = byte(polymod>>(5*(5-i))) & 31
+	}
+
+	return checksum
+}
+
+// convertBits regroups a sequence of fromBits-wide values into toBits-wide values.
+// When pad is false, it returns nil if the input leaves non-zero or excess padding bits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) []byte {
+	var acc uint32
+	var bits uint
+	maxValue := uint32(1)<<toBits - 1
+
+	result := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, v := range data {
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxValue))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxValue != 0 {
+		return nil
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+package web3
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Valid and invalid Bech32 strings from the BIP-173 test vectors.
+func TestBech32DecodeValid(t *testing.T) {
+	tests := []string{
+		"A12UEL5L",
+		"a12uel5l",
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		"11qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqc8247j",
+	}
+
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			hrp, data, err := Bech32Decode(s)
+			if err != nil {
+				t.Fatalf("Bech32Decode(%q) returned error: %v", s, err)
+			}
+
+			encoded, err := Bech32Encode(hrp, data)
+			if err != nil {
+				t.Fatalf("Bech32Encode(%q) returned error: %v", hrp, err)
+			}
+
+			if encoded != strings.ToLower(s) {
+				t.Errorf("Bech32Encode(Bech32Decode(%q)) = %q", s, encoded)
+			}
+		})
+	}
+}
+
+func TestBech32DecodeInvalid(t *testing.T) {
+	tests := map[string]string{
+		"HRP character out of range (0x20)":  "\x201nwldj5",
+		"HRP character out of range (0x7f)":  "\x7f1axkwrx",
+		"HRP character out of range (0x80)":  "\x801eym55h",
+		"overall max length exceeded":        "an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx",
+		"no separator character":             "pzry9x0s0muk",
+		"empty HRP":                          "1pzry9x0s0muk",
+		"invalid data character":             "x1b4n0q5v",
+		"too short checksum":                 "li1dgmt3",
+		"invalid character in checksum":      "de1lg7wt\xff",
+		"checksum calculated with uppercase": "A1G7SGD8",
+		"empty HRP with data":                "10a06t8",
+		"empty HRP with checksum":            "1qzzfhee",
+		"mixed case":                         "A12uEL5L",
+	}
+
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := Bech32Decode(s); !errors.Is(err, ErrInvalidBech32) {
+				t.Errorf("Bech32Decode(%q) error = %v, want ErrInvalidBech32", s, err)
+			}
+		})
+	}
+}
+
+func TestBech32RoundTrip(t *testing.T) {
+	data := []byte{0x00, 0x14, 0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94, 0x1c}
+
+	encoded, err := Bech32Encode("cosmos", data)
+	if err != nil {
+		t.Fatalf("Bech32Encode() returned error: %v", err)
+	}
+
+	hrp, decoded, err := Bech32Decode(encoded)
+	if err != nil {
+		t.Fatalf("Bech32Decode(%q) returned error: %v", encoded, err)
+	}
+
+	if hrp != "cosmos" || !bytes.Equal(decoded, data) {
+		t.Errorf("Bech32Decode(%q) = %q, %x, want %q, %x", encoded, hrp, decoded, "cosmos", data)
+	}
+}
@@ -0,0 +1,77 @@
+package web3
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// BloomLength is the length of a logs bloom filter in bytes (2048 bits).
+const BloomLength = 256
+
+// BloomContains reports whether a value may have been added to a logs bloom filter, such
+// as the logsBloom of a block header or receipt.
+//
+// Log addresses and topics are added to the bloom, so a block can be skipped when the
+// address or topic of interest is not contained. Bloom filters may report false positives
+// but never false negatives.
+//
+// Parameters:
+//   - bloom: A byte slice containing the 256-byte bloom filter.
+//   - value: A byte slice containing the value to look up, e.g. a 20-byte address or a 32-byte topic.
+//
+// Returns:
+//   - bool: true if all three bits of the value are set, false otherwise or if the bloom is not 256 bytes long.
+func BloomContains(bloom []byte, value []byte) bool {
+	if len(bloom) != BloomLength {
+		return false
+	}
+
+	for _, bit := range bloomBits(value) {
+		if bloom[bit.index]&bit.mask == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BloomAdd adds a value to a logs bloom filter in place.
+//
+// Parameters:
+//   - bloom: A byte slice containing the 256-byte bloom filter to be updated.
+//   - value: A byte slice containing the value to add, e.g. a 20-byte address or a 32-byte topic.
+//
+// Returns:
+//   - error: An error if the bloom is not 256 bytes long.
+func BloomAdd(bloom, value []byte) error {
+	if len(bloom) != BloomLength {
+		return fmt.Errorf("invalid bloom length: expected %d bytes, got %d", BloomLength, len(bloom))
+	}
+
+	for _, bit := range bloomBits(value) {
+		bloom[bit.index] |= bit.mask
+	}
+
+	return nil
+}
+
+// bloomBit locates a single bit of a bloom filter.
+type bloomBit struct {
+	index int
+	mask  byte
+}
+
+// bloomBits computes the three bloom filter bits of a value. Each bit position is taken
+// from the low 11 bits of a pair of bytes of the value's Keccak-256 hash, and bit 0 is the
+// lowest bit of the last byte of the bloom.
+func bloomBits(value []byte) [3]bloomBit {
+	hash := Keccak(value)
+
+	var bits [3]bloomBit
+	for i := range bits {
+		position := binary.BigEndian.Uint16(hash[2*i:]) & 2047
+		bits[i] = bloomBit{index: BloomLength - 1 - int(position/8), mask: 1 << (position % 8)}
+	}
+
+	return bits
+}
@@ -0,0 +1,50 @@
+package web3
+
+import (
+	"bytes"
+	"crypto/subtle"
+)
+
+// CodeHash computes the code hash of a contract, i.e. the Keccak-256 hash of its deployed
+// (runtime) bytecode as returned by eth_getCode.
+//
+// Parameters:
+//   - bytecode: A byte slice containing the deployed bytecode.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte code hash.
+func CodeHash(bytecode []byte) []byte {
+	return Keccak(bytecode)
+}
+
+// CompareCodeHash checks in constant time whether deployed bytecode matches an expected
+// code hash, e.g. to verify that a contract runs the audited code.
+//
+// Parameters:
+//   - bytecode: A byte slice containing the deployed bytecode.
+//   - expected: A byte slice containing the expected 32-byte code hash.
+//
+// Returns:
+//   - bool: true if the code hash of the bytecode equals the expected hash, false otherwise.
+func CompareCodeHash(bytecode []byte, expected []byte) bool {
+	return subtle.ConstantTimeCompare(CodeHash(bytecode), expected) == 1
+}
+
+// IsContractCodeHash reports whether an account code hash belongs to an account that has code.
+//
+// Accounts without code report EmptyCodeHash, the Keccak-256 hash of empty bytecode, while
+// accounts that do not exist at all are reported with a zero hash by eth_getProof. Both
+// conventions are treated as "no code".
+//
+// Parameters:
+//   - codeHash: A byte slice containing the 32-byte code hash of the account.
+//
+// Returns:
+//   - bool: true if the hash is a 32-byte hash of non-empty code, false otherwise.
+func IsContractCodeHash(codeHash []byte) bool {
+	if len(codeHash) != HashLength {
+		return false
+	}
+
+	return !bytes.Equal(codeHash, EmptyCodeHash.Bytes()) && !bytes.Equal(codeHash, make([]byte, HashLength))
+}
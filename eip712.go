@@ -0,0 +1,85 @@
+package web3
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// eip712DomainType is the canonical encoding of the EIP712Domain struct used by DomainSeparator.
+const eip712DomainType = "EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"
+
+// TypeHash computes the EIP-712 type hash of an encoded struct type.
+//
+// Parameters:
+//   - typeString: A string containing the encoded type, e.g.
+//     "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte Keccak-256 hash of the encoded type.
+func TypeHash(typeString string) []byte {
+	return Keccak([]byte(typeString))
+}
+
+// HashStruct computes the EIP-712 hashStruct of a struct value.
+//
+// The result is keccak256(typeHash || encodedFields), where encodedFields is the
+// concatenation of the 32-byte encoded member values in declaration order. Dynamic
+// values (string, bytes) must be encoded as their Keccak-256 hash and nested structs
+// as their own hashStruct.
+//
+// Parameters:
+//   - typeHash: A byte slice containing the 32-byte type hash, see TypeHash.
+//   - encodedFields: A byte slice containing the concatenated 32-byte encoded fields.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte struct hash.
+func HashStruct(typeHash []byte, encodedFields []byte) []byte {
+	return Keccak(ConcatBytes(typeHash, encodedFields))
+}
+
+// DomainSeparator computes the EIP-712 domain separator for a domain consisting of
+// a name, version, chain ID and verifying contract.
+//
+// Parameters:
+//   - name: The user readable name of the signing domain.
+//   - version: The current major version of the signing domain.
+//   - chainID: The EIP-155 chain ID of the network.
+//   - verifyingContract: A byte slice containing the 20-byte address of the verifying contract.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte domain separator.
+//   - error: An error if the chain ID does not fit in 32 bytes or the contract address is
+//     not 20 bytes long.
+func DomainSeparator(name, version string, chainID *big.Int, verifyingContract []byte) ([]byte, error) {
+	if len(verifyingContract) != AddressLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, AddressLength, len(verifyingContract))
+	}
+
+	chainIDWord, err := BigIntTo32Bytes(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedFields := ConcatBytes(
+		Keccak([]byte(name)),
+		Keccak([]byte(version)),
+		chainIDWord,
+		PadTo32Bytes(verifyingContract),
+	)
+
+	return HashStruct(TypeHash(eip712DomainType), encodedFields), nil
+}
+
+// Encode712 computes the final EIP-712 digest to be signed for a typed data message.
+//
+// The digest is keccak256("\x19\x01" || domainSeparator || structHash).
+//
+// Parameters:
+//   - domainSeparator: A byte slice containing the 32-byte domain separator.
+//   - structHash: A byte slice containing the 32-byte hashStruct of the message.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte digest.
+func Encode712(domainSeparator, structHash []byte) []byte {
+	return Keccak(ConcatBytes([]byte{0x19, 0x01}, domainSeparator, structHash))
+}
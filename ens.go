@@ -0,0 +1,31 @@
+package web3
+
+import (
+	"strings"
+)
+
+// Namehash computes the ENS namehash of a domain name as specified in EIP-137.
+//
+// Starting from 32 zero bytes, the labels are processed from right to left and each
+// step computes node = keccak256(node || keccak256(label)). The empty name hashes to
+// 32 zero bytes. The name is expected to already be normalized (ENSIP-15); this
+// function only splits it into labels.
+//
+// Parameters:
+//   - name: A string containing the domain name, e.g. "vitalik.eth".
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte namehash.
+func Namehash(name string) []byte {
+	node := make([]byte, 32)
+	if name == "" {
+		return node
+	}
+
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = Keccak(ConcatBytes(node, Keccak([]byte(labels[i]))))
+	}
+
+	return node
+}
@@ -0,0 +1,72 @@
+package web3
+
+import (
+	"bytes"
+)
+
+// erc20Method describes an ERC-20 method recognized by DecodeERC20Call.
+type erc20Method struct {
+	name      string
+	signature string
+	// args holds the argument names in order; the last argument is always the amount.
+	args []string
+}
+
+// erc20Methods lists the state-changing ERC-20 methods recognized by DecodeERC20Call.
+var erc20Methods = []erc20Method{
+	{name: "transfer", signature: "transfer(address,uint256)", args: []string{"to", "amount"}},
+	{name: "transferFrom", signature: "transferFrom(address,address,uint256)", args: []string{"from", "to", "amount"}},
+	{name: "approve", signature: "approve(address,uint256)", args: []string{"spender", "amount"}},
+}
+
+// DecodeERC20Call recognizes and decodes calldata of the ERC-20 transfer, transferFrom
+// and approve methods.
+//
+// Address arguments are returned checksummed and the amount is returned as a decimal
+// string. Calldata whose length does not match the method's arguments, or whose address
+// words are not properly zero-padded, is not recognized.
+//
+// Parameters:
+//   - data: A byte slice containing the transaction calldata.
+//
+// Returns:
+//   - method: The method name, e.g. "transfer".
+//   - args: The decoded arguments by name: "to", "from", "spender" and "amount".
+//   - ok: true if the calldata was recognized and decoded, false otherwise.
+func DecodeERC20Call(data []byte) (method string, args map[string]string, ok bool) {
+	if len(data) < SelectorLength {
+		return "", nil, false
+	}
+
+	selector, words := data[:SelectorLength], data[SelectorLength:]
+
+	for _, m := range erc20Methods {
+		if !bytes.Equal(FunctionSelector(m.signature), selector) {
+			continue
+		}
+
+		if len(words) != len(m.args)*WordLength {
+			return "", nil, false
+		}
+
+		args = make(map[string]string, len(m.args))
+		for i, name := range m.args {
+			word := words[i*WordLength : (i+1)*WordLength]
+
+			if i == len(m.args)-1 {
+				args[name] = BytesToBigInt(word, false).String()
+				continue
+			}
+
+			address, err := DecodeAddress(word)
+			if err != nil {
+				return "", nil, false
+			}
+			args[name] = address
+		}
+
+		return m.name, args, true
+	}
+
+	return "", nil, false
+}
@@ -0,0 +1,36 @@
+package web3
+
+import (
+	"errors"
+)
+
+// Sentinel errors returned (wrapped) by the functions of this package, so callers can
+// distinguish failure types with errors.Is.
+var (
+	// ErrInvalidAddressLength is returned when an address does not consist of exactly 20 bytes.
+	ErrInvalidAddressLength = errors.New("invalid address length")
+
+	// ErrInvalidHex is returned when a string is not a valid hexadecimal representation.
+	ErrInvalidHex = errors.New("invalid hex string")
+
+	// ErrValueTooLarge is returned when a value does not fit in a 32-byte word.
+	ErrValueTooLarge = errors.New("value exceeds 32 bytes")
+
+	// ErrInvalidSignature is returned when a signature is malformed or cannot be recovered.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrInvalidPublicKey is returned when a public key has an invalid length or prefix.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+
+	// ErrInvalidPrivateKey is returned when a private key is not a valid secp256k1 scalar.
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+
+	// ErrInvalidBase58 is returned when a string is not a valid Base58 or Base58Check encoding.
+	ErrInvalidBase58 = errors.New("invalid base58 string")
+
+	// ErrInvalidBech32 is returned when a string is not a valid Bech32 encoding.
+	ErrInvalidBech32 = errors.New("invalid bech32 string")
+
+	// ErrInvalidDerivationPath is returned when a BIP-32 derivation path is malformed.
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
+)
@@ -2,6 +2,10 @@ module github.com/outofboxer/go-web3
 
 go 1.24.1
 
-require golang.org/x/crypto v0.36.0
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
+	golang.org/x/crypto v0.36.0
+	golang.org/x/text v0.23.0
+)
 
 require golang.org/x/sys v0.31.0 // indirect
@@ -0,0 +1,73 @@
+package web3
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// HashLength is the length of a Keccak-256 hash in bytes.
+const HashLength = 32
+
+// Hash represents a 32-byte value such as a Keccak-256 hash, a transaction hash,
+// a storage key or a log topic.
+type Hash [HashLength]byte
+
+var (
+	// EmptyKeccak256 is the Keccak-256 hash of empty input,
+	// 0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470.
+	EmptyKeccak256 = Keccak256Hash(nil)
+
+	// EmptyCodeHash is the code hash of an account without code, such as an externally
+	// owned account, as returned in the codeHash field of eth_getProof.
+	EmptyCodeHash = EmptyKeccak256
+)
+
+// BytesToHash converts a byte slice into a Hash, left-padding it with zero bytes
+// if it is shorter than 32 bytes.
+//
+// Parameters:
+//   - b: A byte slice containing at most 32 bytes.
+//
+// Returns:
+//   - Hash: The resulting hash, with the input right-justified.
+//   - error: An error if the input is longer than 32 bytes.
+func BytesToHash(b []byte) (Hash, error) {
+	var h Hash
+
+	if len(b) > HashLength {
+		return h, fmt.Errorf("%w: got %d", ErrValueTooLarge, len(b))
+	}
+
+	copy(h[HashLength-len(b):], b)
+
+	return h, nil
+}
+
+// Keccak256Hash computes the Keccak-256 hash of the input data and returns it as a Hash.
+//
+// Parameters:
+//   - input: A byte slice containing the data to be hashed.
+//
+// Returns:
+//   - Hash: The Keccak-256 hash of the input data.
+func Keccak256Hash(input []byte) Hash {
+	var h Hash
+	copy(h[:], Keccak(input))
+
+	return h
+}
+
+// Hex returns the lowercase hexadecimal representation of the hash, including the "0x" prefix.
+func (h Hash) Hex() string {
+	return "0x" + hex.EncodeToString(h[:])
+}
+
+// String implements fmt.Stringer and returns the same value as Hex.
+func (h Hash) String() string {
+	return h.Hex()
+}
+
+// Bytes returns the hash as a byte slice.
+func (h Hash) Bytes() []byte {
+	return h[:]
+}
@@ -0,0 +1,136 @@
+package web3
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// HardenedKeyStart is the first BIP-32 child index of a hardened key, written as i', ih or iH in a path.
+const HardenedKeyStart = 0x80000000
+
+// bip32MasterKey is the HMAC key used to derive the BIP-32 master key from a seed.
+var bip32MasterKey = []byte("Bitcoin seed")
+
+// bip39Iterations is the number of PBKDF2 rounds used by BIP-39 seed derivation.
+const bip39Iterations = 2048
+
+// MnemonicToSeed derives the 64-byte BIP-39 seed from a mnemonic sentence.
+//
+// The seed is PBKDF2-HMAC-SHA512 over the mnemonic with the salt "mnemonic" + passphrase
+// and 2048 iterations. Both inputs are NFKD normalized first, as required by BIP-39. The
+// mnemonic is not checked against a wordlist, so any sentence yields a seed.
+//
+// Parameters:
+//   - mnemonic: The mnemonic sentence, with words separated by single spaces.
+//   - passphrase: The optional BIP-39 passphrase, or an empty string.
+//
+// Returns:
+//   - []byte: A byte slice containing the 64-byte seed, usable as the BIP-32 master seed.
+func MnemonicToSeed(mnemonic string, passphrase string) []byte {
+	password := norm.NFKD.Bytes([]byte(mnemonic))
+	salt := norm.NFKD.Bytes([]byte("mnemonic" + passphrase))
+
+	return pbkdf2.Key(password, salt, bip39Iterations, sha512.Size, sha512.New)
+}
+
+// DeriveKey derives a private key from a seed along a BIP-32 derivation path.
+//
+// The path starts with "m" followed by "/"-separated child indexes, e.g. "m/44'/60'/0'/0/0"
+// for the first Ethereum account. Indexes suffixed with "'", "h" or "H" are hardened. In the
+// astronomically unlikely case that a derivation step yields an invalid key, an error is
+// returned rather than skipping to the next index.
+//
+// Parameters:
+//   - seed: A byte slice containing the master seed, e.g. from MnemonicToSeed.
+//   - path: The BIP-32 derivation path.
+//
+// Returns:
+//   - priv: A byte slice containing the 32-byte private key, usable with PrivateKeyToAddress.
+//   - chainCode: A byte slice containing the 32-byte chain code of the derived key.
+//   - err: An error if the path is malformed or an invalid key is derived.
+func DeriveKey(seed []byte, path string) (priv []byte, chainCode []byte, err error) {
+	indexes, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	digest := ComputeHMAC(seed, bip32MasterKey, sha512.New)
+	priv, chainCode = digest[:32], digest[32:]
+	if _, err := parsePrivateKey(priv); err != nil {
+		return nil, nil, fmt.Errorf("invalid master key: %w", err)
+	}
+
+	for _, index := range indexes {
+		priv, chainCode, err = deriveChildKey(priv, chainCode, index)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return priv, chainCode, nil
+}
+
+// parseDerivationPath parses a BIP-32 path such as "m/44'/60'/0'/0/0" into child indexes.
+func parseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("%w: %q must start with \"m\"", ErrInvalidDerivationPath, path)
+	}
+
+	indexes := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		var offset uint32
+		if strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H") {
+			offset = HardenedKeyStart
+			segment = segment[:len(segment)-1]
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid index %q in %q", ErrInvalidDerivationPath, segment, path)
+		}
+
+		indexes = append(indexes, uint32(index)+offset)
+	}
+
+	return indexes, nil
+}
+
+// deriveChildKey computes the BIP-32 child private key and chain code at the given index.
+func deriveChildKey(priv, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	key, err := parsePrivateKey(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Hardened children commit to the private key, normal children to the compressed public key
+	var data []byte
+	if index >= HardenedKeyStart {
+		data = ConcatBytes([]byte{0}, priv)
+	} else {
+		data = key.PubKey().SerializeCompressed()
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	digest := ComputeHMAC(data, chainCode, sha512.New)
+
+	tweak := new(big.Int).SetBytes(digest[:32])
+	if tweak.Cmp(secp256k1N) >= 0 {
+		return nil, nil, fmt.Errorf("%w: derived key at index %d out of range", ErrInvalidPrivateKey, index)
+	}
+
+	child := tweak.Add(tweak, new(big.Int).SetBytes(priv))
+	child.Mod(child, secp256k1N)
+	if child.Sign() == 0 {
+		return nil, nil, fmt.Errorf("%w: derived key at index %d is zero", ErrInvalidPrivateKey, index)
+	}
+
+	return child.FillBytes(make([]byte, 32)), digest[32:], nil
+}
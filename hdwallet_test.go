@@ -0,0 +1,82 @@
+package web3
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// BIP-39 test vector from the reference implementation's vectors.json.
+func TestMnemonicToSeed(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	want := "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"
+
+	if got := hex.EncodeToString(MnemonicToSeed(mnemonic, "TREZOR")); got != want {
+		t.Errorf("MnemonicToSeed() = %s, want %s", got, want)
+	}
+}
+
+// BIP-32 test vector 1.
+func TestDeriveKey(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		path      string
+		priv      string
+		chainCode string
+	}{
+		{"m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508"},
+		{"m/0H", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea", "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141"},
+		{"m/0H/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368", "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19"},
+		{"m/0H/1/2H", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca", "04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f"},
+		{"m/0H/1/2H/2", "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4", "cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd"},
+		{"m/0H/1/2H/2/1000000000", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8", "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e"},
+		{"m/0'/1/2h/2/1000000000", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8", "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			priv, chainCode, err := DeriveKey(seed, tt.path)
+			if err != nil {
+				t.Fatalf("DeriveKey(%q) returned error: %v", tt.path, err)
+			}
+
+			if got := hex.EncodeToString(priv); got != tt.priv {
+				t.Errorf("DeriveKey(%q) priv = %s, want %s", tt.path, got, tt.priv)
+			}
+
+			if got := hex.EncodeToString(chainCode); got != tt.chainCode {
+				t.Errorf("DeriveKey(%q) chainCode = %s, want %s", tt.path, got, tt.chainCode)
+			}
+		})
+	}
+}
+
+// The well-known development mnemonic used by Hardhat and Foundry.
+func TestDeriveKeyEthereumAccount(t *testing.T) {
+	seed := MnemonicToSeed("test test test test test test test test test test test junk", "")
+
+	priv, _, err := DeriveKey(seed, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("DeriveKey() returned error: %v", err)
+	}
+
+	address, err := PrivateKeyToAddress(priv)
+	if err != nil {
+		t.Fatalf("PrivateKeyToAddress() returned error: %v", err)
+	}
+
+	if want := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"; address != want {
+		t.Errorf("derived address = %s, want %s", address, want)
+	}
+}
+
+func TestDeriveKeyInvalidPath(t *testing.T) {
+	seed := make([]byte, 16)
+
+	for _, path := range []string{"", "0/1", "m/", "m/x", "m/-1", "m/2147483648", "m/1''", "M/0"} {
+		if _, _, err := DeriveKey(seed, path); !errors.Is(err, ErrInvalidDerivationPath) {
+			t.Errorf("DeriveKey(%q) error = %v, want ErrInvalidDerivationPath", path, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package web3
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// HexToBytes decodes a hexadecimal string into a byte slice.
+//
+// The string may optionally be prefixed with "0x" or "0X". Odd-length strings are tolerated by
+// left-padding a single zero nibble, so "0x1" decodes to []byte{0x01}.
+//
+// Parameters:
+//   - s: A string containing a hexadecimal representation of bytes, optionally prefixed with '0x'.
+//
+// Returns:
+//   - []byte: A byte slice containing the decoded data.
+//   - error: An error if the input string is not a valid hexadecimal representation.
+func HexToBytes(s string) ([]byte, error) {
+	s = StripHexPrefix(s)
+
+	if len(s)%2 != 0 {
+		s = "0" + s
+	}
+
+	return decodeHex(s)
+}
+
+// StrictHexToBytes decodes a canonical "0x"-prefixed hexadecimal string into a byte slice.
+//
+// Unlike HexToBytes, the "0x" prefix is mandatory and odd-length strings are rejected,
+// which makes it suitable for protocol code that must enforce the canonical representation.
+//
+// Parameters:
+//   - s: A string containing a "0x"-prefixed hexadecimal representation of bytes.
+//
+// Returns:
+//   - []byte: A byte slice containing the decoded data.
+//   - error: An error if the prefix is missing or the input is not valid even-length hexadecimal.
+func StrictHexToBytes(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("%w %q: missing 0x prefix", ErrInvalidHex, s)
+	}
+
+	return decodeHex(s[2:])
+}
+
+// StripHexPrefix removes a leading "0x" or "0X" prefix from a string, if present.
+//
+// The function is idempotent: only a single prefix is removed and strings without a
+// prefix are returned unchanged.
+//
+// Parameters:
+//   - s: A string, optionally prefixed with "0x" or "0X".
+//
+// Returns:
+//   - string: The string without its hexadecimal prefix.
+func StripHexPrefix(s string) string {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+
+	return s
+}
+
+// EnsureHexPrefix adds a "0x" prefix to a string, normalizing an existing "0X" prefix.
+//
+// The function is idempotent: a string that already has a prefix is not prefixed again.
+//
+// Parameters:
+//   - s: A string, optionally prefixed with "0x" or "0X".
+//
+// Returns:
+//   - string: The string with exactly one lowercase "0x" prefix.
+func EnsureHexPrefix(s string) string {
+	return "0x" + StripHexPrefix(s)
+}
+
+// BytesToHex encodes a byte slice as a lowercase hexadecimal string.
+//
+// Parameters:
+//   - b: A byte slice containing the data to be encoded.
+//
+// Returns:
+//   - string: The hexadecimal representation of the data, including the "0x" prefix.
+func BytesToHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// decodeHex decodes a bare hexadecimal string, wrapping decoding failures in ErrInvalidHex.
+func decodeHex(s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+
+	return b, nil
+}
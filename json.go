@@ -0,0 +1,45 @@
+package web3
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// CanonicalJSON marshals a value into a canonical JSON form suitable for hashing or
+// signing, so both parties of a signed request agree on the exact bytes.
+//
+// Object keys are sorted at every nesting level (including struct fields, which are
+// otherwise emitted in declaration order), insignificant whitespace is omitted and HTML
+// characters are not escaped. Numbers are kept exactly as encoding/json formats them.
+//
+// Parameters:
+//   - v: The value to be marshaled, as accepted by json.Marshal.
+//
+// Returns:
+//   - []byte: A byte slice containing the canonical JSON encoding.
+//   - error: An error if the value cannot be marshaled to JSON.
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode into generic maps and slices, whose keys encoding/json always sorts
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var canonical bytes.Buffer
+	encoder := json.NewEncoder(&canonical)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(generic); err != nil {
+		return nil, err
+	}
+
+	// Encode terminates the value with a newline
+	return bytes.TrimSuffix(canonical.Bytes(), []byte("\n")), nil
+}
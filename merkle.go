@@ -0,0 +1,91 @@
+package web3
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// MerkleRoot computes the root of a Keccak-256 Merkle tree over the given leaves.
+//
+// Sibling nodes are sorted before being hashed, following the OpenZeppelin MerkleProof
+// convention, so proofs do not need to encode left/right positions. When a level has an
+// odd number of nodes, the last node is promoted to the next level unchanged. Leaves are
+// used as given, so they should already be hashed (e.g. with Keccak).
+//
+// Parameters:
+//   - leaves: A slice of byte slices containing the leaf hashes, in tree order.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte Merkle root, or nil if there are no leaves.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		level = merkleParentLevel(level)
+	}
+
+	return level[0]
+}
+
+// MerkleProof computes the proof for the leaf at the given index of a Merkle tree built
+// the same way as MerkleRoot.
+//
+// The returned proof is the list of sibling hashes from the leaf up to the root and can
+// be passed as-is to OpenZeppelin's MerkleProof.verify.
+//
+// Parameters:
+//   - leaves: A slice of byte slices containing the leaf hashes, in tree order.
+//   - index: The index of the leaf to prove.
+//
+// Returns:
+//   - [][]byte: A slice of sibling hashes forming the proof.
+//   - error: An error if the index is out of range.
+func MerkleProof(leaves [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, fmt.Errorf("leaf index out of range: %d", index)
+	}
+
+	var proof [][]byte
+
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+
+		level = merkleParentLevel(level)
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// merkleParentLevel hashes each pair of nodes of a Merkle tree level into the level above.
+func merkleParentLevel(level [][]byte) [][]byte {
+	parents := make([][]byte, 0, (len(level)+1)/2)
+
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			// Promote the odd node unchanged
+			parents = append(parents, level[i])
+			continue
+		}
+
+		parents = append(parents, hashSortedPair(level[i], level[i+1]))
+	}
+
+	return parents
+}
+
+// hashSortedPair computes keccak256 of the two nodes concatenated in ascending byte order.
+func hashSortedPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	return Keccak(ConcatBytes(a, b))
+}
@@ -0,0 +1,73 @@
+package web3
+
+import (
+	"encoding/binary"
+)
+
+// EncodeRLPBytes encodes a byte string using Recursive Length Prefix (RLP) encoding.
+//
+// A single byte below 0x80 is its own encoding. Strings of up to 55 bytes are prefixed
+// with 0x80 plus their length, and longer strings are prefixed with 0xb7 plus the length
+// of the big-endian encoded length, followed by that length.
+//
+// Parameters:
+//   - b: A byte slice containing the string to be encoded.
+//
+// Returns:
+//   - []byte: A byte slice containing the RLP encoding of the string.
+func EncodeRLPBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+
+	return ConcatBytes(rlpHeader(0x80, len(b)), b)
+}
+
+// EncodeRLPList encodes a list using Recursive Length Prefix (RLP) encoding.
+//
+// Each item must already be RLP encoded (with EncodeRLPBytes or EncodeRLPList), which
+// allows lists to be nested. The concatenated payload is prefixed with 0xc0 plus its
+// length for payloads of up to 55 bytes, or with 0xf7 plus the length of the big-endian
+// encoded length, followed by that length.
+//
+// Parameters:
+//   - items: A variadic parameter of RLP encoded items, in list order.
+//
+// Returns:
+//   - []byte: A byte slice containing the RLP encoding of the list.
+func EncodeRLPList(items ...[]byte) []byte {
+	payload := ConcatBytes(items...)
+
+	return ConcatBytes(rlpHeader(0xc0, len(payload)), payload)
+}
+
+// rlpHeader builds the RLP prefix for a string (offset 0x80) or list (offset 0xc0)
+// payload of the given length.
+func rlpHeader(offset byte, length int) []byte {
+	if length <= 55 {
+		return []byte{offset + byte(length)}
+	}
+
+	lengthBytes := TrimLeftZeros(binary.BigEndian.AppendUint64(nil, uint64(length)))
+
+	return ConcatBytes([]byte{offset + 55 + byte(len(lengthBytes))}, lengthBytes)
+}
+
+// EncodeRLPUint encodes an unsigned integer using Recursive Length Prefix (RLP) encoding.
+//
+// The integer is encoded as the RLP string of its minimal big-endian representation,
+// so zero is encoded as the empty string (0x80).
+//
+// Parameters:
+//   - n: The unsigned integer to be encoded.
+//
+// Returns:
+//   - []byte: A byte slice containing the RLP encoding of the integer.
+func EncodeRLPUint(n uint64) []byte {
+	b := binary.BigEndian.AppendUint64(nil, n)
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+
+	return EncodeRLPBytes(b)
+}
@@ -0,0 +1,82 @@
+package web3
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// EncodeQuantity encodes a non-negative integer as an Ethereum JSON-RPC QUANTITY.
+//
+// Quantities are "0x"-prefixed hexadecimal strings without leading zeros, so zero is
+// encoded as "0x0" and 1024 as "0x400".
+//
+// Parameters:
+//   - n: A non-negative big.Int containing the value to be encoded.
+//
+// Returns:
+//   - string: The compact hexadecimal representation of the value, including the "0x" prefix.
+//   - error: An error if the value is negative, since quantities are unsigned.
+func EncodeQuantity(n *big.Int) (string, error) {
+	if n.Sign() < 0 {
+		return "", fmt.Errorf("invalid quantity %s: negative value", n)
+	}
+
+	return "0x" + n.Text(16), nil
+}
+
+// DecodeQuantity decodes an Ethereum JSON-RPC QUANTITY into an integer.
+//
+// The "0x" prefix is required. Odd-length values such as "0x1" are accepted, as are
+// (non-canonical) leading zeros.
+//
+// Parameters:
+//   - s: A string containing the "0x"-prefixed hexadecimal quantity.
+//
+// Returns:
+//   - *big.Int: The decoded non-negative value.
+//   - error: An error if the prefix is missing or the digits are not valid hexadecimal.
+func DecodeQuantity(s string) (*big.Int, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("%w %q: missing 0x prefix", ErrInvalidHex, s)
+	}
+
+	digits := s[2:]
+	if digits == "" || strings.ContainsAny(digits, "+-") {
+		return nil, fmt.Errorf("%w %q: invalid quantity", ErrInvalidHex, s)
+	}
+
+	n, ok := new(big.Int).SetString(digits, 16)
+	if !ok {
+		return nil, fmt.Errorf("%w %q: invalid quantity", ErrInvalidHex, s)
+	}
+
+	return n, nil
+}
+
+// EncodeData encodes a byte array as an Ethereum JSON-RPC DATA value.
+//
+// Unlike quantities, DATA values keep their leading zeros and always contain two hex
+// digits per byte, so an empty array is encoded as "0x".
+//
+// Parameters:
+//   - b: A byte slice containing the data to be encoded.
+//
+// Returns:
+//   - string: The even-length hexadecimal representation of the data, including the "0x" prefix.
+func EncodeData(b []byte) string {
+	return BytesToHex(b)
+}
+
+// DecodeData decodes an Ethereum JSON-RPC DATA value into a byte array.
+//
+// Parameters:
+//   - s: A string containing the "0x"-prefixed, even-length hexadecimal data.
+//
+// Returns:
+//   - []byte: A byte slice containing the decoded data.
+//   - error: An error if the prefix is missing, the length is odd or the digits are not
+//     valid hexadecimal.
+func DecodeData(s string) ([]byte, error) {
+	return StrictHexToBytes(s)
+}
@@ -0,0 +1,261 @@
+package web3
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// SignatureLength is the length of an r || s || v secp256k1 signature in bytes.
+const SignatureLength = 65
+
+var (
+	// secp256k1N is the order of the secp256k1 curve group.
+	secp256k1N = secp256k1.Params().N
+	// secp256k1HalfN is half the order of the secp256k1 curve group, the upper bound of a low S value.
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// PersonalSignHash computes the EIP-191 (version 0x45) hash of a message, as used by
+// the personal_sign and eth_sign JSON-RPC methods.
+//
+// The hash is keccak256("\x19Ethereum Signed Message:\n" + len(message) + message),
+// where the length is written in decimal.
+//
+// Parameters:
+//   - message: A byte slice containing the message that was signed.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte hash to be signed or recovered from.
+func PersonalSignHash(message []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+
+	return Keccak(ConcatBytes([]byte(prefix), message))
+}
+
+// RecoverAddress recovers the address of the account that produced a secp256k1 signature.
+//
+// The signature must be 65 bytes in the r || s || v layout returned by wallets, where v
+// is the recovery ID either as 0/1 or as 27/28. The recovered public key is Keccak-256
+// hashed and the last 20 bytes of the hash are returned as a checksummed address.
+//
+// Parameters:
+//   - hash: A byte slice containing the 32-byte hash that was signed, e.g. from PersonalSignHash.
+//   - sig: A byte slice containing the 65-byte signature.
+//
+// Returns:
+//   - string: The checksummed address of the signer, including the "0x" prefix.
+//   - error: An error if the hash or signature is malformed or the key cannot be recovered.
+func RecoverAddress(hash []byte, sig []byte) (string, error) {
+	if len(hash) != 32 {
+		return "", fmt.Errorf("invalid hash length: expected 32 bytes, got %d", len(hash))
+	}
+
+	r, s, v, err := SplitSignature(sig)
+	if err != nil {
+		return "", err
+	}
+
+	if v != 27 && v != 28 {
+		return "", fmt.Errorf("%w: recovery id %d", ErrInvalidSignature, sig[64])
+	}
+
+	// Compact signatures are laid out as <27 + recovery id> || r || s
+	compact := ConcatBytes([]byte{v}, r[:], s[:])
+
+	pub, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	return PublicKeyToAddress(pub.SerializeUncompressed())
+}
+
+// SplitSignature splits a 65-byte r || s || v secp256k1 signature into its components.
+//
+// A recovery ID given as 0 or 1 is normalized to 27 or 28, so the returned v always uses
+// the convention expected by Solidity's ecrecover. Other v values are returned unchanged.
+//
+// Parameters:
+//   - sig: A byte slice containing the 65-byte signature.
+//
+// Returns:
+//   - r: The 32-byte R component of the signature.
+//   - s: The 32-byte S component of the signature.
+//   - v: The recovery byte, normalized to 27/28 when given as 0/1.
+//   - err: An error if the signature is not 65 bytes long.
+func SplitSignature(sig []byte) (r, s [32]byte, v byte, err error) {
+	if len(sig) != SignatureLength {
+		return r, s, 0, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidSignature, SignatureLength, len(sig))
+	}
+
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:64])
+
+	v = sig[64]
+	if v == 0 || v == 1 {
+		v += 27
+	}
+
+	return r, s, v, nil
+}
+
+// PublicKeyToAddress derives the Ethereum address of an uncompressed secp256k1 public key.
+//
+// The address is the last 20 bytes of the Keccak-256 hash of the 64-byte X || Y
+// encoding of the key.
+//
+// Parameters:
+//   - pub: A byte slice containing the uncompressed public key, either as 64 bytes (X || Y)
+//     or as 65 bytes with the 0x04 prefix.
+//
+// Returns:
+//   - string: The checksummed address, including the "0x" prefix.
+//   - error: An error if the public key has an invalid length or prefix.
+func PublicKeyToAddress(pub []byte) (string, error) {
+	switch {
+	case len(pub) == 65 && pub[0] == 0x04:
+		pub = pub[1:]
+	case len(pub) == 65:
+		return "", fmt.Errorf("%w: expected 0x04 prefix, got 0x%02x", ErrInvalidPublicKey, pub[0])
+	case len(pub) != 64:
+		return "", fmt.Errorf("%w: expected 64 or 65 bytes, got %d", ErrInvalidPublicKey, len(pub))
+	}
+
+	addressHash := Keccak(pub)
+
+	return ToChecksumAddress(addressHash[12:])
+}
+
+// IsLowS reports whether the S value of a signature is in the lower half of the
+// secp256k1 curve order, as required for canonical signatures by EIP-2.
+//
+// Parameters:
+//   - s: A byte slice containing the 32-byte big-endian S value, e.g. from SplitSignature.
+//
+// Returns:
+//   - bool: true if S is 32 bytes long and in the range [1, N/2], false otherwise.
+func IsLowS(s []byte) bool {
+	if len(s) != 32 {
+		return false
+	}
+
+	value := new(big.Int).SetBytes(s)
+
+	return value.Sign() > 0 && value.Cmp(secp256k1HalfN) <= 0
+}
+
+// NormalizeS converts the S value of a signature to its canonical low form.
+//
+// A high S value is replaced with N - S, which yields an equally valid signature; note
+// that this flips the recovery ID, so the v value of the signature must be flipped too
+// (27 <-> 28). Low S values are returned unchanged.
+//
+// Parameters:
+//   - s: A byte slice containing the 32-byte big-endian S value.
+//
+// Returns:
+//   - []byte: A 32-byte slice containing the low S value.
+//   - error: An error if S is not 32 bytes long or not in the range [1, N-1].
+func NormalizeS(s []byte) ([]byte, error) {
+	if len(s) != 32 {
+		return nil, fmt.Errorf("%w: expected 32-byte S value, got %d bytes", ErrInvalidSignature, len(s))
+	}
+
+	value := new(big.Int).SetBytes(s)
+	if value.Sign() == 0 || value.Cmp(secp256k1N) >= 0 {
+		return nil, fmt.Errorf("%w: S value out of range", ErrInvalidSignature)
+	}
+
+	if value.Cmp(secp256k1HalfN) > 0 {
+		value.Sub(secp256k1N, value)
+	}
+
+	return value.FillBytes(make([]byte, 32)), nil
+}
+
+// PrivateKeyToAddress derives the Ethereum address of a raw secp256k1 private key.
+//
+// Parameters:
+//   - priv: A byte slice containing the 32-byte big-endian private key scalar.
+//
+// Returns:
+//   - string: The checksummed address, including the "0x" prefix.
+//   - error: An error if the key is not 32 bytes long or not in the range [1, N-1].
+func PrivateKeyToAddress(priv []byte) (string, error) {
+	key, err := parsePrivateKey(priv)
+	if err != nil {
+		return "", err
+	}
+
+	return PublicKeyToAddress(key.PubKey().SerializeUncompressed())
+}
+
+// NormalizePrivateKey validates a hexadecimal private key and returns its raw 32-byte form.
+//
+// Surrounding whitespace and an optional "0x" prefix are removed, and the key must decode
+// to exactly 32 bytes forming a scalar in the range [1, N-1], where N is the secp256k1
+// curve order.
+//
+// Parameters:
+//   - hexKey: A string containing the hexadecimal private key.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte private key.
+//   - error: An error if the key is not valid hexadecimal, not 32 bytes long or out of range.
+func NormalizePrivateKey(hexKey string) ([]byte, error) {
+	priv, err := hex.DecodeString(StripHexPrefix(strings.TrimSpace(hexKey)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %v", ErrInvalidPrivateKey, ErrInvalidHex, err)
+	}
+
+	if _, err := parsePrivateKey(priv); err != nil {
+		return nil, err
+	}
+
+	return priv, nil
+}
+
+// SignMessage signs a message with a secp256k1 private key the way personal_sign does.
+//
+// The message is hashed with PersonalSignHash and signed deterministically (RFC 6979).
+// The signature is canonical, i.e. its S value is in the lower half of the curve order,
+// so it can be checked with RecoverAddress or Solidity's ecrecover.
+//
+// Parameters:
+//   - message: A byte slice containing the message to be signed.
+//   - priv: A byte slice containing the 32-byte private key.
+//
+// Returns:
+//   - []byte: A byte slice containing the 65-byte r || s || v signature, with v in {27, 28}.
+//   - error: An error if the private key is invalid.
+func SignMessage(message []byte, priv []byte) ([]byte, error) {
+	key, err := parsePrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compact signatures are laid out as <27 + recovery id> || r || s
+	compact := ecdsa.SignCompact(key, PersonalSignHash(message), false)
+
+	return ConcatBytes(compact[1:], compact[:1]), nil
+}
+
+// parsePrivateKey validates a raw 32-byte private key scalar and converts it to a secp256k1 key.
+func parsePrivateKey(priv []byte) (*secp256k1.PrivateKey, error) {
+	if len(priv) != 32 {
+		return nil, fmt.Errorf("%w: expected 32 bytes, got %d", ErrInvalidPrivateKey, len(priv))
+	}
+
+	scalar := new(big.Int).SetBytes(priv)
+	if scalar.Sign() == 0 || scalar.Cmp(secp256k1N) >= 0 {
+		return nil, fmt.Errorf("%w: scalar out of range", ErrInvalidPrivateKey)
+	}
+
+	return secp256k1.PrivKeyFromBytes(priv), nil
+}
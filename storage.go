@@ -0,0 +1,103 @@
+package web3
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MappingSlot computes the storage slot of a mapping value in Solidity's storage layout.
+//
+// For a mapping declared at slot p, the value for key k is stored at
+// keccak256(pad32(k) || pad32(p)). This applies to value-type keys (addresses, integers,
+// fixed-size bytes), which are left-padded to 32 bytes.
+//
+// Parameters:
+//   - key: A byte slice containing the mapping key, at most 32 bytes long.
+//   - slot: The storage slot at which the mapping is declared.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte storage slot, usable with eth_getStorageAt.
+//   - error: An error if the key is longer than 32 bytes.
+func MappingSlot(key []byte, slot uint64) ([]byte, error) {
+	word, err := PadTo32BytesStrict(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mapping key: %w", err)
+	}
+
+	return Keccak(ConcatBytes(word, uint64ToWord(slot))), nil
+}
+
+// ArraySlot computes the storage slot at which the elements of a dynamic array start.
+//
+// For a dynamic array declared at slot p, the length is stored at p itself and the
+// elements are stored consecutively starting at keccak256(pad32(p)).
+//
+// Parameters:
+//   - slot: The storage slot at which the dynamic array is declared.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte storage slot of the first element.
+func ArraySlot(slot uint64) []byte {
+	return Keccak(uint64ToWord(slot))
+}
+
+// DynamicArrayElementSlot computes the storage slot of an element of a dynamic array whose
+// elements each occupy a full storage slot (e.g. uint256[] or address[]).
+//
+// The element at index i is stored at keccak256(pad32(p)) + i, wrapping modulo 2^256.
+//
+// Parameters:
+//   - slot: The storage slot at which the dynamic array is declared.
+//   - index: The index of the element.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte storage slot of the element.
+func DynamicArrayElementSlot(slot uint64, index uint64) []byte {
+	element := new(big.Int).SetBytes(ArraySlot(slot))
+	element.Add(element, new(big.Int).SetUint64(index))
+	element.Mod(element, twoTo256)
+
+	return element.FillBytes(make([]byte, WordLength))
+}
+
+// NestedMappingSlot computes the storage slot of a value in a nested mapping, such as
+// mapping(address => mapping(address => uint256)) used for ERC-20 allowances.
+//
+// The MappingSlot derivation is applied once per key, outermost key first, each time
+// using the previous result as the slot of the inner mapping.
+//
+// Parameters:
+//   - keys: The mapping keys from the outermost to the innermost mapping, each at most 32 bytes long.
+//   - baseSlot: The storage slot at which the outermost mapping is declared.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte storage slot of the value.
+//   - error: An error if any key is longer than 32 bytes.
+func NestedMappingSlot(keys [][]byte, baseSlot uint64) ([]byte, error) {
+	slot := uint64ToWord(baseSlot)
+	for i, key := range keys {
+		word, err := PadTo32BytesStrict(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mapping key at index %d: %w", i, err)
+		}
+		slot = Keccak(ConcatBytes(word, slot))
+	}
+
+	return slot, nil
+}
+
+// LongBytesSlot computes the storage slot at which the data of a long string or bytes
+// variable starts.
+//
+// Values of 32 bytes or more declared at slot p store 2*length+1 at p itself and their
+// data consecutively starting at keccak256(pad32(p)). Shorter values are stored inline
+// at p and do not use this slot.
+//
+// Parameters:
+//   - slot: The storage slot at which the string or bytes variable is declared.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte storage slot of the first data chunk.
+func LongBytesSlot(slot uint64) []byte {
+	return Keccak(uint64ToWord(slot))
+}
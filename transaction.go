@@ -0,0 +1,198 @@
+package web3
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// TransactionHash computes the hash of a signed raw transaction.
+//
+// The hash is the Keccak-256 hash of the full serialized transaction, which holds for
+// both legacy RLP transactions and EIP-2718 typed transactions (type byte || payload).
+//
+// Parameters:
+//   - rawTx: A byte slice containing the signed serialized transaction.
+//
+// Returns:
+//   - string: The transaction hash as a hexadecimal string, including the "0x" prefix.
+func TransactionHash(rawTx []byte) string {
+	return BytesToHex(Keccak(rawTx))
+}
+
+// NextNonce returns the nonce to use for the transaction following one sent with the given nonce.
+//
+// EIP-2681 caps transaction nonces below 2^64-1, so an error is returned once the account
+// has no usable nonce left.
+//
+// Parameters:
+//   - nonce: The nonce of the last transaction sent by the account.
+//
+// Returns:
+//   - uint64: The nonce of the next transaction.
+//   - error: An error if the next nonce would be 2^64-1 or more.
+func NextNonce(nonce uint64) (uint64, error) {
+	if nonce >= math.MaxUint64-1 {
+		return 0, fmt.Errorf("nonce %d exhausted: no usable nonce follows it", nonce)
+	}
+
+	return nonce + 1, nil
+}
+
+// ValidateNonceSequence checks that a transaction nonce is the next one expected for an account.
+//
+// Parameters:
+//   - expected: The next nonce of the account, e.g. from eth_getTransactionCount with "pending".
+//   - provided: The nonce of the transaction to be sent.
+//
+// Returns:
+//   - error: nil if the nonces match, otherwise an error describing the reuse of an already
+//     used nonce or the size of the gap that would leave the transaction pending.
+func ValidateNonceSequence(expected, provided uint64) error {
+	switch {
+	case provided < expected:
+		return fmt.Errorf("nonce %d already used: expected %d", provided, expected)
+	case provided > expected:
+		return fmt.Errorf("nonce gap: expected %d, got %d (%d missing)", expected, provided, provided-expected)
+	}
+
+	return nil
+}
+
+// EncodeEIP155V computes the v value of a replay-protected legacy transaction signature
+// as specified by EIP-155: v = chainID*2 + 35 + recoveryID.
+//
+// Parameters:
+//   - chainID: The EIP-155 chain ID of the network.
+//   - recoveryID: The signature recovery ID, 0 or 1.
+//
+// Returns:
+//   - uint64: The EIP-155 v value.
+func EncodeEIP155V(chainID uint64, recoveryID byte) uint64 {
+	return chainID*2 + 35 + uint64(recoveryID)
+}
+
+// DecodeEIP155V extracts the chain ID and recovery ID from the v value of a legacy
+// transaction signature.
+//
+// Values 35 and above are EIP-155 replay-protected. The pre-EIP-155 values 27/28 and the
+// raw recovery IDs 0/1 are reported as unprotected with a chain ID of 0. Any other value
+// is invalid and yields all zero results.
+//
+// Parameters:
+//   - v: The v value of the signature.
+//
+// Returns:
+//   - chainID: The chain ID encoded in v, or 0 if the signature is not replay-protected.
+//   - recoveryID: The signature recovery ID, 0 or 1.
+//   - protected: true if v is an EIP-155 replay-protected value, false otherwise.
+func DecodeEIP155V(v uint64) (chainID uint64, recoveryID byte, protected bool) {
+	switch {
+	case v >= 35:
+		return (v - 35) / 2, byte((v - 35) % 2), true
+	case v == 27 || v == 28:
+		return 0, byte(v - 27), false
+	case v <= 1:
+		return 0, byte(v), false
+	default:
+		return 0, 0, false
+	}
+}
+
+// AccessListEntry is a single entry of an EIP-2930 access list: an address together
+// with the storage keys of that address the transaction plans to access.
+type AccessListEntry struct {
+	// Address is the 20-byte address of the accessed account.
+	Address []byte
+	// StorageKeys are the 32-byte storage slots accessed at Address.
+	StorageKeys [][]byte
+}
+
+// EncodeAccessList encodes an EIP-2930 access list using RLP, as embedded in EIP-2930
+// and EIP-1559 typed transactions.
+//
+// The list is encoded as [[address, [storageKey, ...]], ...]. Addresses and storage keys
+// are encoded as given, so they should be 20 and 32 bytes long respectively.
+//
+// Parameters:
+//   - list: The access list entries, in order.
+//
+// Returns:
+//   - []byte: A byte slice containing the RLP encoding of the access list.
+func EncodeAccessList(list []AccessListEntry) []byte {
+	entries := make([][]byte, len(list))
+
+	for i, entry := range list {
+		keys := make([][]byte, len(entry.StorageKeys))
+		for j, key := range entry.StorageKeys {
+			keys[j] = EncodeRLPBytes(key)
+		}
+
+		entries[i] = EncodeRLPList(EncodeRLPBytes(entry.Address), EncodeRLPList(keys...))
+	}
+
+	return EncodeRLPList(entries...)
+}
+
+// baseFeeChangeDenominator bounds the EIP-1559 base fee change to 1/8 per block.
+const baseFeeChangeDenominator = 8
+
+// EffectiveGasPrice computes the gas price actually paid by an EIP-1559 transaction,
+// min(maxFee, baseFee + maxPriorityFee).
+//
+// Parameters:
+//   - baseFee: The base fee per gas of the block including the transaction.
+//   - maxPriorityFee: The maxPriorityFeePerGas of the transaction.
+//   - maxFee: The maxFeePerGas of the transaction.
+//
+// Returns:
+//   - *big.Int: The effective gas price in wei.
+func EffectiveGasPrice(baseFee, maxPriorityFee, maxFee *big.Int) *big.Int {
+	price := new(big.Int).Add(baseFee, maxPriorityFee)
+	if price.Cmp(maxFee) > 0 {
+		price.Set(maxFee)
+	}
+
+	return price
+}
+
+// NextBaseFee computes the base fee per gas of the next block from its parent block
+// according to the EIP-1559 adjustment formula.
+//
+// The base fee grows when the parent used more gas than its target and shrinks when it
+// used less, by at most 1/8 per block. An increase is always at least 1 wei.
+//
+// Parameters:
+//   - parentBaseFee: The base fee per gas of the parent block.
+//   - gasUsed: The gas used by the parent block.
+//   - gasTarget: The gas target of the parent block, i.e. its gas limit divided by the elasticity multiplier.
+//
+// Returns:
+//   - *big.Int: The base fee per gas of the next block.
+func NextBaseFee(parentBaseFee *big.Int, gasUsed, gasTarget uint64) *big.Int {
+	if gasUsed == gasTarget || gasTarget == 0 {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	target := new(big.Int).SetUint64(gasTarget)
+	denominator := big.NewInt(baseFeeChangeDenominator)
+
+	if gasUsed > gasTarget {
+		// delta = max(parentBaseFee * (gasUsed - gasTarget) / gasTarget / 8, 1)
+		delta := new(big.Int).Mul(parentBaseFee, new(big.Int).SetUint64(gasUsed-gasTarget))
+		delta.Quo(delta, target)
+		delta.Quo(delta, denominator)
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+
+		return delta.Add(parentBaseFee, delta)
+	}
+
+	// delta = parentBaseFee * (gasTarget - gasUsed) / gasTarget / 8
+	delta := new(big.Int).Mul(parentBaseFee, new(big.Int).SetUint64(gasTarget-gasUsed))
+	delta.Quo(delta, target)
+	delta.Quo(delta, denominator)
+
+	return delta.Sub(parentBaseFee, delta)
+}
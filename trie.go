@@ -0,0 +1,134 @@
+package web3
+
+import (
+	"bytes"
+	"sort"
+)
+
+// OrderedTrieRoot computes the root hash of a Merkle-Patricia trie holding a list of items
+// keyed by their RLP encoded index, as used for the transactionsRoot and receiptsRoot of
+// block headers.
+//
+// Each item is stored as given, so transactions and receipts must already be in their
+// consensus encoding (RLP, or type byte || payload for EIP-2718 typed envelopes). Items
+// are expected to be non-empty, since a trie does not store empty values.
+//
+// Parameters:
+//   - items: The items of the list, in order.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte trie root. An empty list yields the empty
+//     trie root 0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421.
+func OrderedTrieRoot(items [][]byte) []byte {
+	entries := make([]trieEntry, len(items))
+	for i, item := range items {
+		entries[i] = trieEntry{key: keyToNibbles(EncodeRLPUint(uint64(i))), value: item}
+	}
+
+	// The construction below relies on the entries being sorted by key
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	if len(entries) == 0 {
+		return Keccak(EncodeRLPBytes(nil))
+	}
+
+	return Keccak(encodeTrieNode(entries, 0))
+}
+
+// trieEntry is a key-value pair of a trie, with the key split into nibbles.
+type trieEntry struct {
+	key   []byte
+	value []byte
+}
+
+// encodeTrieNode returns the RLP encoding of the node holding a non-empty set of sorted
+// entries whose keys share their first depth nibbles.
+func encodeTrieNode(entries []trieEntry, depth int) []byte {
+	if len(entries) == 1 {
+		leaf := entries[0]
+		return EncodeRLPList(EncodeRLPBytes(hexPrefix(leaf.key[depth:], true)), EncodeRLPBytes(leaf.value))
+	}
+
+	// Shared nibbles below depth form an extension node; since the entries are sorted,
+	// the first and last keys bound the common prefix of all of them
+	first, last := entries[0].key, entries[len(entries)-1].key
+	shared := 0
+	for depth+shared < len(first) && depth+shared < len(last) && first[depth+shared] == last[depth+shared] {
+		shared++
+	}
+
+	if shared > 0 {
+		child := encodeTrieNode(entries, depth+shared)
+		return EncodeRLPList(EncodeRLPBytes(hexPrefix(first[depth:depth+shared], false)), trieNodeReference(child))
+	}
+
+	// Otherwise branch on the next nibble, with a key ending here stored in the value slot
+	slots := make([][]byte, 17)
+	for i := range slots {
+		slots[i] = EncodeRLPBytes(nil)
+	}
+
+	for start := 0; start < len(entries); {
+		if len(entries[start].key) == depth {
+			slots[16] = EncodeRLPBytes(entries[start].value)
+			start++
+			continue
+		}
+
+		nibble := entries[start].key[depth]
+		end := start + 1
+		for end < len(entries) && entries[end].key[depth] == nibble {
+			end++
+		}
+
+		slots[nibble] = trieNodeReference(encodeTrieNode(entries[start:end], depth+1))
+		start = end
+	}
+
+	return EncodeRLPList(slots...)
+}
+
+// trieNodeReference returns how a child node is referenced from its parent: nodes shorter
+// than 32 bytes are embedded directly, larger ones by their Keccak-256 hash.
+func trieNodeReference(node []byte) []byte {
+	if len(node) < HashLength {
+		return node
+	}
+
+	return EncodeRLPBytes(Keccak(node))
+}
+
+// keyToNibbles splits a key into its 4-bit nibbles, high nibble first.
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+
+	return nibbles
+}
+
+// hexPrefix packs a nibble path into bytes using the hex-prefix encoding, whose first
+// nibble flags whether the node is a leaf and whether the path has odd length.
+func hexPrefix(nibbles []byte, leaf bool) []byte {
+	var flag byte
+	if leaf {
+		flag = 2
+	}
+
+	var packed []byte
+	if len(nibbles)%2 == 1 {
+		packed = append(packed, (flag+1)<<4|nibbles[0])
+		nibbles = nibbles[1:]
+	} else {
+		packed = append(packed, flag<<4)
+	}
+
+	for i := 0; i < len(nibbles); i += 2 {
+		packed = append(packed, nibbles[i]<<4|nibbles[i+1])
+	}
+
+	return packed
+}
@@ -0,0 +1,65 @@
+package web3
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// trieTestItems returns n distinct items of varying length, so that the trie contains both
+// embedded and hashed nodes.
+func trieTestItems(n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		items[i] = []byte(strings.Repeat(fmt.Sprintf("item-%d;", i), i%7+1))
+	}
+
+	return items
+}
+
+// The expected roots were computed with go-ethereum's types.DeriveSha over the same items.
+func TestOrderedTrieRoot(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"},
+		{1, "0x3d4910b78b5f6b30f9de30fc1cc9c3f32a832a4c53ee6feaa4541ab5ecdfe04b"},
+		{2, "0x3920c30a8da4666737f5d101f2f297a234b8e7bad82bcb1085bcb463e4ae0b77"},
+		{3, "0xd8b71cba18737e039872fa2d2fffb7923f26d8b1f79999d5afa1481764585bce"},
+		{16, "0x4cbc8f15f866ad21bde37a25e42c4894423770da6962ce2ffa8f6d4453ec34b9"},
+		{17, "0xa307198933d9467a56632d3638adc25d708015125f1234ec7f4e4ba245805678"},
+		{128, "0x2345d544658d402558046e2cc3f93bddfe83433bdd493a8ffadceb9c17e043c5"},
+		{129, "0x5ba07c24ec2f3c0c53b76c17eace153c7d7f8aa2021d76d2955e027e5c0cbb2d"},
+		{300, "0xfc306889c64103a0ee096ea50bc5709223f98a6d5c54c98fd289a139d4d99288"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d items", tt.n), func(t *testing.T) {
+			if got := BytesToHex(OrderedTrieRoot(trieTestItems(tt.n))); got != tt.want {
+				t.Errorf("OrderedTrieRoot(%d items) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedTrieRootShortItems(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{3, "0x7212ba90919b517ec43266a4223e445fdb0c0bd5cb45187e7698f771cee150ba"},
+		{20, "0x84d0b1e90b089fa3dcbc0d09a61c6118317cb5f07fdfccd57f82abd216cfd8c4"},
+	}
+
+	for _, tt := range tests {
+		items := make([][]byte, tt.n)
+		for i := range items {
+			items[i] = []byte{byte(i + 1)}
+		}
+
+		if got := BytesToHex(OrderedTrieRoot(items)); got != tt.want {
+			t.Errorf("OrderedTrieRoot(%d one-byte items) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
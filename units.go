@@ -0,0 +1,234 @@
+package web3
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal places of the common Ether denominations relative to wei.
+const (
+	WeiDecimals   = 0
+	GweiDecimals  = 9
+	EtherDecimals = 18
+)
+
+// unitPrecision is the mantissa precision, in bits, used for big.Float results.
+// It comfortably exceeds the 256 bits needed to represent any uint256 wei amount.
+const unitPrecision = 512
+
+// WeiToEther converts an amount in wei to an amount in ether.
+//
+// Parameters:
+//   - wei: A big.Int containing the amount in wei.
+//
+// Returns:
+//   - *big.Float: The equivalent amount in ether.
+func WeiToEther(wei *big.Int) *big.Float {
+	// EtherDecimals is non-negative, so the conversion cannot fail
+	ether, _ := FromWei(wei, EtherDecimals)
+
+	return ether
+}
+
+// EtherToWei converts an amount in ether to an amount in wei.
+//
+// Parameters:
+//   - ether: A big.Float containing the amount in ether.
+//
+// Returns:
+//   - *big.Int: The equivalent amount in wei, rounded to the nearest integer.
+//   - error: An error if the amount is infinite.
+func EtherToWei(ether *big.Float) (*big.Int, error) {
+	return ToWei(ether, EtherDecimals)
+}
+
+// ToWei converts an amount expressed in a unit with the given number of decimals
+// (e.g. 18 for ether, 6 for USDC) to its smallest integer denomination.
+//
+// A big.Float holds a binary approximation of decimal values such as 0.1, so the amount is
+// first converted to the shortest decimal that identifies it at its own precision, and that
+// decimal is scaled exactly. Values such as big.NewFloat(0.1) ether therefore yield exactly
+// 10^17 wei, but the result can be no more accurate than the precision of the amount. Use
+// parsing of a decimal string where exact amounts matter, e.g. ParseGwei.
+//
+// Parameters:
+//   - amount: A big.Float containing the amount to be converted.
+//   - decimals: The number of decimal places of the unit the amount is expressed in, at least 0.
+//
+// Returns:
+//   - *big.Int: The equivalent integer amount, rounded half away from zero to the nearest integer.
+//   - error: An error if decimals is negative or the amount is infinite.
+func ToWei(amount *big.Float, decimals int) (*big.Int, error) {
+	if decimals < 0 {
+		return nil, fmt.Errorf("invalid decimals %d: must not be negative", decimals)
+	}
+
+	if amount.IsInf() {
+		return nil, fmt.Errorf("invalid amount %s: infinite", amount.String())
+	}
+
+	// The shortest decimal representation drops the binary rounding error of the amount
+	value, ok := new(big.Rat).SetString(amount.Text('g', -1))
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %s", amount.String())
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	value.Mul(value, new(big.Rat).SetInt(scale))
+
+	// Round half away from zero; the denominator of a big.Rat is always positive
+	wei, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Lsh(remainder.Abs(remainder), 1).Cmp(value.Denom()) >= 0 {
+		wei.Add(wei, big.NewInt(int64(value.Num().Sign())))
+	}
+
+	return wei, nil
+}
+
+// FromWei converts an integer amount in the smallest denomination to an amount
+// expressed in a unit with the given number of decimals.
+//
+// Parameters:
+//   - wei: A big.Int containing the integer amount to be converted.
+//   - decimals: The number of decimal places of the target unit, at least 0.
+//
+// Returns:
+//   - *big.Float: The equivalent amount in the target unit.
+//   - error: An error if decimals is negative.
+func FromWei(wei *big.Int, decimals int) (*big.Float, error) {
+	if decimals < 0 {
+		return nil, fmt.Errorf("invalid decimals %d: must not be negative", decimals)
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+
+	numerator := new(big.Float).SetPrec(unitPrecision).SetInt(wei)
+	denominator := new(big.Float).SetPrec(unitPrecision).SetInt(divisor)
+
+	return numerator.Quo(numerator, denominator), nil
+}
+
+// FormatGwei formats an amount in wei as a human-readable gwei string.
+//
+// The conversion is exact and trailing zeros of the fractional part are trimmed,
+// e.g. 25500000000 wei is formatted as "25.5 gwei".
+//
+// Parameters:
+//   - wei: A big.Int containing the amount in wei.
+//
+// Returns:
+//   - string: The amount in gwei followed by the " gwei" unit suffix.
+func FormatGwei(wei *big.Int) string {
+	return formatUnits(wei, GweiDecimals) + " gwei"
+}
+
+// ParseGwei parses a decimal gwei amount, such as "25.5" or "25.5 gwei", into wei.
+//
+// Parameters:
+//   - s: A string containing the decimal amount in gwei, optionally followed by the "gwei" unit.
+//
+// Returns:
+//   - *big.Int: The equivalent amount in wei.
+//   - error: An error if the string is not a valid unsigned decimal number or has more than 9
+//     fractional digits, which cannot be represented in wei.
+func ParseGwei(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(strings.ToLower(s), "gwei") {
+		s = strings.TrimSpace(s[:len(s)-len("gwei")])
+	}
+
+	return parseUnits(s, GweiDecimals)
+}
+
+// FormatWeiHuman formats an amount in wei for display, grouping the integer digits in
+// thousands with commas.
+//
+// The amount is converted exactly to a unit with the given number of decimals, and trailing
+// zeros of the fractional part are trimmed. The caller chooses the denomination, since the
+// number of decimals alone does not tell ether apart from an 18-decimal token, e.g.
+// 1234567890 wei formats as "1,234,567,890 wei" with 0 decimals and unit "wei", and as
+// "1.23456789" with 9 decimals and no unit.
+//
+// Parameters:
+//   - wei: A big.Int containing the amount in wei or in the smallest unit of a token.
+//   - decimals: The number of decimals of the unit to display, at least 0, e.g. WeiDecimals, GweiDecimals or EtherDecimals.
+//   - unit: The denomination appended after a space, e.g. "gwei" or "DAI", or an empty string for none.
+//
+// Returns:
+//   - string: The formatted amount, followed by the unit if one is given.
+//   - error: An error if decimals is negative.
+func FormatWeiHuman(wei *big.Int, decimals int, unit string) (string, error) {
+	if decimals < 0 {
+		return "", fmt.Errorf("invalid decimals %d: must not be negative", decimals)
+	}
+
+	text := formatUnits(wei, decimals)
+
+	sign := ""
+	if strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(text, ".")
+
+	var grouped strings.Builder
+	for i := 0; i < len(integer); i++ {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteByte(integer[i])
+	}
+
+	text = sign + grouped.String()
+	if hasFraction {
+		text += "." + fraction
+	}
+
+	if unit != "" {
+		text += " " + unit
+	}
+
+	return text, nil
+}
+
+// formatUnits formats an integer amount as an exact decimal string with the given
+// non-negative number of decimals, trimming trailing zeros of the fractional part.
+func formatUnits(amount *big.Int, decimals int) string {
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+
+	integer, fraction := new(big.Int).QuoRem(new(big.Int).Abs(amount), divisor, new(big.Int))
+
+	text := integer.String()
+	if fraction.Sign() != 0 {
+		fractionText := fmt.Sprintf("%0*s", decimals, fraction.String())
+		text += "." + strings.TrimRight(fractionText, "0")
+	}
+
+	if amount.Sign() < 0 {
+		text = "-" + text
+	}
+
+	return text
+}
+
+// parseUnits parses an exact unsigned decimal string into an integer amount with the given
+// number of decimals, rejecting signs and values that would lose precision.
+func parseUnits(s string, decimals int) (*big.Int, error) {
+	integer, fraction, _ := strings.Cut(s, ".")
+
+	if integer+fraction == "" || strings.ContainsAny(s, "+-") {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+
+	if len(fraction) > decimals {
+		return nil, fmt.Errorf("invalid amount %q: more than %d fractional digits", s, decimals)
+	}
+
+	amount, ok := new(big.Int).SetString(integer+fraction+strings.Repeat("0", decimals-len(fraction)), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+
+	return amount, nil
+}
@@ -1,13 +1,25 @@
 package web3
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/crypto/sha3"
+	"hash"
+	"math/big"
+	"strconv"
 	"strings"
 )
 
+// AddressLength is the expected length of an Ethereum address in bytes.
+const AddressLength = 20
+
 // ComputeHMACDigest calculates the HMAC (Hash-based Message Authentication Code) digest
 // of a given message using SHA-256 as the underlying hash function.
 //
@@ -16,19 +28,115 @@ import (
 //   - secret: A byte slice containing the secret key used for HMAC computation.
 //
 // Returns:
-//   - A byte slice containing the computed HMAC digest.
+//   - A byte slice containing the raw (not hex-encoded) 32-byte HMAC digest.
 func ComputeHMACDigest(message, secret []byte) []byte {
-	// Create a new HMAC hasher with SHA-256
-	h := hmac.New(sha256.New, secret)
+	return ComputeHMAC(message, secret, sha256.New)
+}
+
+// ComputeHMACDigestHex calculates the SHA-256 HMAC digest of a given message and returns
+// it as a lowercase hexadecimal string, the form expected by most HTTP signature headers.
+//
+// Parameters:
+//   - message: A byte slice containing the message to be authenticated.
+//   - secret: A byte slice containing the secret key used for HMAC computation.
+//
+// Returns:
+//   - A string containing the 64-character hexadecimal HMAC digest, without a "0x" prefix.
+func ComputeHMACDigestHex(message, secret []byte) string {
+	return hex.EncodeToString(ComputeHMACDigest(message, secret))
+}
+
+// ComputeHMAC calculates the HMAC digest of a given message using the provided
+// hash constructor as the underlying hash function (e.g. sha512.New).
+//
+// Parameters:
+//   - message: A byte slice containing the message to be authenticated.
+//   - secret: A byte slice containing the secret key used for HMAC computation.
+//   - h: A function returning a new hash.Hash, such as sha256.New or sha512.New.
+//
+// Returns:
+//   - A byte slice containing the computed HMAC digest.
+func ComputeHMAC(message, secret []byte, h func() hash.Hash) []byte {
+	// Create a new HMAC hasher with the given hash function
+	mac := hmac.New(h, secret)
 
 	// Write the message to the hasher
-	h.Write(message)
+	mac.Write(message)
 
 	// Compute the final HMAC digest
-	hashed := h.Sum(nil)
+	return mac.Sum(nil)
+}
+
+// HMACWriter computes a SHA-256 HMAC digest incrementally over everything written to it,
+// so large messages can be authenticated without being held in memory.
+type HMACWriter struct {
+	mac hash.Hash
+}
+
+// NewHMACWriter creates an HMACWriter using SHA-256 as the underlying hash function.
+//
+// The returned writer can be used as the destination of io.Copy; once the whole message
+// has been written, Digest returns the same value ComputeHMACDigest would.
+//
+// Parameters:
+//   - secret: A byte slice containing the secret key used for HMAC computation.
+//
+// Returns:
+//   - *HMACWriter: A writer accumulating the HMAC of the written data.
+func NewHMACWriter(secret []byte) *HMACWriter {
+	return &HMACWriter{mac: hmac.New(sha256.New, secret)}
+}
+
+// Write implements io.Writer and adds p to the authenticated message. It never returns an error.
+func (w *HMACWriter) Write(p []byte) (int, error) {
+	return w.mac.Write(p)
+}
+
+// Digest returns the HMAC digest of all data written so far. More data may be written afterwards.
+func (w *HMACWriter) Digest() []byte {
+	return w.mac.Sum(nil)
+}
 
-	// Convert the hash to a hexadecimal string
-	return hashed
+// VerifyHMACDigest checks whether the expected digest matches the SHA-256 HMAC of the message.
+//
+// The digest is recomputed with ComputeHMACDigest and compared in constant time using
+// hmac.Equal, so the comparison does not leak timing information about the expected value.
+//
+// Parameters:
+//   - message: A byte slice containing the message that was authenticated.
+//   - secret: A byte slice containing the secret key used for HMAC computation.
+//   - expected: A byte slice containing the digest to verify, e.g. a webhook signature.
+//
+// Returns:
+//   - bool: true if the expected digest is valid for the message and secret, false otherwise.
+func VerifyHMACDigest(message, secret, expected []byte) bool {
+	return hmac.Equal(ComputeHMACDigest(message, secret), expected)
+}
+
+// SecureCompareHex compares two hexadecimal encoded digests in constant time.
+//
+// Both strings are decoded (an optional "0x" prefix is accepted) and the resulting bytes
+// are compared with subtle.ConstantTimeCompare, so hex strings differing only in letter
+// case are considered equal.
+//
+// Parameters:
+//   - a: A string containing the first hexadecimal digest.
+//   - b: A string containing the second hexadecimal digest.
+//
+// Returns:
+//   - bool: true if both strings are valid hexadecimal and decode to equal bytes, false otherwise.
+func SecureCompareHex(a, b string) bool {
+	digestA, err := hex.DecodeString(StripHexPrefix(a))
+	if err != nil {
+		return false
+	}
+
+	digestB, err := hex.DecodeString(StripHexPrefix(b))
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(digestA, digestB) == 1
 }
 
 // ToChecksumAddress converts a given Ethereum address to a checksummed address
@@ -43,15 +151,59 @@ func ComputeHMACDigest(message, secret []byte) []byte {
 //
 // Returns:
 //   - string: The checksummed Ethereum address as a string, including the "0x" prefix.
-//   - error: An error if the conversion process fails, otherwise nil.
+//   - error: An error if the address is not exactly 20 bytes long, otherwise nil.
 func ToChecksumAddress(a []byte) (string, error) {
+	return ToChecksumAddressWithChainID(a, 0)
+}
+
+// ToChecksumAddressNoPrefix converts a given Ethereum address to a checksummed address
+// according to EIP-55, without the "0x" prefix, for systems that store bare addresses.
+//
+// Parameters:
+//   - a: A byte slice containing the 20-byte Ethereum address to be checksummed.
+//
+// Returns:
+//   - string: The 40-character checksummed address, without the "0x" prefix.
+//   - error: An error if the address is not exactly 20 bytes long, otherwise nil.
+func ToChecksumAddressNoPrefix(a []byte) (string, error) {
+	address, err := ToChecksumAddress(a)
+	if err != nil {
+		return "", err
+	}
+
+	return StripHexPrefix(address), nil
+}
+
+// ToChecksumAddressWithChainID converts a given Ethereum address to a checksummed
+// address according to EIP-1191, which makes the checksum chain-aware.
+//
+// The Keccak-256 hash is computed over the decimal chain ID, followed by "0x" and the
+// lowercase hexadecimal encoding of the address. The same case rules as EIP-55 are then
+// applied. A chain ID of 0 disables the prefix and yields the plain EIP-55 checksum.
+//
+// Parameters:
+//   - a: A byte slice containing the 20-byte Ethereum address to be checksummed.
+//   - chainID: The EIP-155 chain ID (e.g. 30 for RSK mainnet), or 0 for plain EIP-55.
+//
+// Returns:
+//   - string: The checksummed Ethereum address as a string, including the "0x" prefix.
+//   - error: An error if the address is not exactly 20 bytes long, otherwise nil.
+func ToChecksumAddressWithChainID(a []byte, chainID uint64) (string, error) {
+	if len(a) != AddressLength {
+		return "", fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, AddressLength, len(a))
+	}
+
 	address := hex.EncodeToString(a)
-	// Remove the '0x' prefix if it exists
-	// address = strings.ToLower(strings.TrimPrefix(address, "0x"))
 
-	// Compute the Keccak-256 hash of the address
+	// EIP-1191 prepends the chain ID and "0x" to the hashed payload
+	payload := address
+	if chainID != 0 {
+		payload = strconv.FormatUint(chainID, 10) + "0x" + address
+	}
+
+	// Compute the Keccak-256 hash of the payload
 	hasher := sha3.NewLegacyKeccak256()
-	hasher.Write([]byte(address))
+	hasher.Write([]byte(payload))
 	hash := hasher.Sum(nil)
 
 	// Encode hash to hexadecimal
@@ -72,6 +224,51 @@ func ToChecksumAddress(a []byte) (string, error) {
 	return checksumAddress.String(), nil
 }
 
+// ToChecksumAddressFromHex converts a hexadecimal Ethereum address string to a checksummed
+// address according to EIP-55.
+//
+// The string is parsed with ParseAddress, so it may be "0x"-prefixed or bare and use any
+// letter case.
+//
+// Parameters:
+//   - s: A string containing the hexadecimal representation of a 20-byte Ethereum address.
+//
+// Returns:
+//   - string: The checksummed Ethereum address as a string, including the "0x" prefix.
+//   - error: An error if the string is not valid hexadecimal or does not decode to 20 bytes.
+func ToChecksumAddressFromHex(s string) (string, error) {
+	address, err := ParseAddress(s)
+	if err != nil {
+		return "", err
+	}
+
+	return ToChecksumAddress(address)
+}
+
+// ToChecksumAddresses converts a list of Ethereum addresses to checksummed addresses
+// according to EIP-55, as ToChecksumAddress does for a single address.
+//
+// Parameters:
+//   - addrs: A slice of byte slices, each containing a 20-byte Ethereum address.
+//
+// Returns:
+//   - []string: The checksummed addresses, in the same order as the input.
+//   - error: An error identifying the index of the first address that is not 20 bytes long.
+func ToChecksumAddresses(addrs [][]byte) ([]string, error) {
+	checksumAddresses := make([]string, len(addrs))
+
+	for i, a := range addrs {
+		checksumAddress, err := ToChecksumAddress(a)
+		if err != nil {
+			return nil, fmt.Errorf("address at index %d: %w", i, err)
+		}
+
+		checksumAddresses[i] = checksumAddress
+	}
+
+	return checksumAddresses, nil
+}
+
 // IsChecksumAddress validates whether a given Ethereum address string is correctly checksummed.
 //
 // This function checks if the provided address adheres to the EIP-55 checksum format.
@@ -84,6 +281,19 @@ func ToChecksumAddress(a []byte) (string, error) {
 // Returns:
 //   - bool: true if the address is a valid checksummed address, false otherwise.
 func IsChecksumAddress(address string) bool {
+	return IsChecksumAddressWithChainID(address, 0)
+}
+
+// IsChecksumAddressWithChainID validates whether a given Ethereum address string is
+// correctly checksummed according to EIP-1191 for the given chain ID.
+//
+// Parameters:
+//   - address: A string representing the Ethereum address to be validated.
+//   - chainID: The EIP-155 chain ID the checksum was computed for, or 0 for plain EIP-55.
+//
+// Returns:
+//   - bool: true if the address is a valid checksummed address for the chain, false otherwise.
+func IsChecksumAddressWithChainID(address string, chainID uint64) bool {
 	if !strings.HasPrefix(address, "0x") || len(address) != 42 {
 		return false
 	}
@@ -93,7 +303,7 @@ func IsChecksumAddress(address string) bool {
 		return false
 	}
 
-	expectedChecksum, err := ToChecksumAddress(addressHex)
+	expectedChecksum, err := ToChecksumAddressWithChainID(addressHex, chainID)
 	if err != nil {
 		return false
 	}
@@ -101,6 +311,253 @@ func IsChecksumAddress(address string) bool {
 	return address == expectedChecksum
 }
 
+// Checksum states reported by AddressCaseStatus.
+const (
+	// NoChecksum means the address is all lowercase or all uppercase and carries no checksum.
+	NoChecksum = iota
+	// ValidChecksum means the address is mixed case and matches its EIP-55 checksum.
+	ValidChecksum
+	// InvalidChecksum means the address is mixed case but does not match its EIP-55 checksum.
+	InvalidChecksum
+)
+
+// AddressCaseStatus classifies the letter case of an Ethereum address string.
+//
+// Unlike IsChecksumAddress, this function distinguishes addresses that carry no checksum
+// at all (all lowercase or all uppercase, as produced by older tools) from mixed-case
+// addresses whose checksum is wrong, which usually indicates a mistyped address.
+//
+// Parameters:
+//   - address: A string containing the Ethereum address, optionally prefixed with "0x".
+//
+// Returns:
+//   - int: One of NoChecksum, ValidChecksum or InvalidChecksum.
+//   - error: An error if the string is not a valid 20-byte hexadecimal address.
+func AddressCaseStatus(address string) (status int, err error) {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return 0, err
+	}
+
+	addressHex := addressHexDigits(address)
+	if addressHex == strings.ToLower(addressHex) || addressHex == strings.ToUpper(addressHex) {
+		return NoChecksum, nil
+	}
+
+	expectedChecksum, err := ToChecksumAddress(parsed)
+	if err != nil {
+		return 0, err
+	}
+
+	if addressHex != expectedChecksum[2:] {
+		return InvalidChecksum, nil
+	}
+
+	return ValidChecksum, nil
+}
+
+// ChecksumMismatchPositions reports which characters of an address string do not match
+// the letter case required by its EIP-55 checksum.
+//
+// Positions are 0-based indices into the 40 hexadecimal digits of the address, i.e. not
+// counting the "0x" prefix. Digits 0-9 have no case and therefore never mismatch.
+//
+// Parameters:
+//   - address: A string containing the Ethereum address, optionally prefixed with "0x".
+//
+// Returns:
+//   - []int: The positions whose case differs from the checksummed form, empty if the
+//     address is correctly checksummed.
+//   - error: An error if the string is not a valid 20-byte hexadecimal address.
+func ChecksumMismatchPositions(address string) ([]int, error) {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedChecksum, err := ToChecksumAddress(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	addressHex := addressHexDigits(address)
+	expectedHex := expectedChecksum[2:]
+
+	positions := []int{}
+	for i := range addressHex {
+		if addressHex[i] != expectedHex[i] {
+			positions = append(positions, i)
+		}
+	}
+
+	return positions, nil
+}
+
+// addressHexDigits returns the hexadecimal digits of an address string as given, without
+// surrounding whitespace and without a "0x" or "0X" prefix.
+func addressHexDigits(address string) string {
+	return StripHexPrefix(strings.TrimSpace(address))
+}
+
+// ParseAddress normalizes a hexadecimal Ethereum address string into its raw 20-byte form.
+//
+// The input may be surrounded by whitespace, may include an optional "0x" prefix and may
+// use any letter case. Checksums are not validated; use IsChecksumAddress for that.
+//
+// Parameters:
+//   - s: A string containing the hexadecimal representation of an Ethereum address.
+//
+// Returns:
+//   - []byte: A byte slice containing the 20-byte address.
+//   - error: An error if the string is not valid hexadecimal or does not decode to 20 bytes.
+func ParseAddress(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	s = strings.ToLower(StripHexPrefix(s))
+
+	address, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+
+	if len(address) != AddressLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, AddressLength, len(address))
+	}
+
+	return address, nil
+}
+
+// AddressEqual reports whether two address strings refer to the same 20-byte address.
+//
+// Both addresses are parsed with ParseAddress, so the comparison ignores the "0x" prefix
+// and letter case, and therefore checksum differences.
+//
+// Parameters:
+//   - a: A string containing the first Ethereum address.
+//   - b: A string containing the second Ethereum address.
+//
+// Returns:
+//   - bool: true if both addresses are valid and equal, false otherwise.
+func AddressEqual(a, b string) bool {
+	addressA, err := ParseAddress(a)
+	if err != nil {
+		return false
+	}
+
+	addressB, err := ParseAddress(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(addressA, addressB)
+}
+
+// IsZeroAddress reports whether the given address string is the zero address (0x000...0).
+//
+// The address is parsed with ParseAddress, so both checksummed and lowercase forms are
+// accepted, with or without the "0x" prefix.
+//
+// Parameters:
+//   - address: A string containing the Ethereum address to be checked.
+//
+// Returns:
+//   - bool: true if the address is valid and all 20 bytes are zero, false otherwise.
+func IsZeroAddress(address string) bool {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(parsed, make([]byte, AddressLength))
+}
+
+// HasLeadingZeroBytes counts the leading zero bytes of an Ethereum address.
+//
+// Addresses starting with zero bytes are the target of the "short address attack" and
+// are easy to display or truncate incorrectly, so wallets may want to warn about them.
+// The address is parsed with ParseAddress, so checksummed and lowercase forms are accepted.
+//
+// Parameters:
+//   - address: A string containing the Ethereum address to be checked.
+//
+// Returns:
+//   - int: The number of leading zero bytes, between 0 and 20.
+//   - error: An error if the string is not a valid 20-byte hexadecimal address.
+func HasLeadingZeroBytes(address string) (int, error) {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for count < len(parsed) && parsed[count] == 0 {
+		count++
+	}
+
+	return count, nil
+}
+
+// ShortenAddress shortens an Ethereum address for display, e.g. "0x1234...abcd".
+//
+// The result keeps the "0x" prefix, the first 4 and the last 4 hexadecimal digits of the
+// address, preserving their original letter case so that a checksummed address stays
+// recognizable.
+//
+// Parameters:
+//   - address: A string containing the Ethereum address, optionally prefixed with "0x".
+//
+// Returns:
+//   - string: The shortened address.
+//   - error: An error if the string is not a valid 20-byte hexadecimal address.
+func ShortenAddress(address string) (string, error) {
+	if _, err := ParseAddress(address); err != nil {
+		return "", err
+	}
+
+	addressHex := addressHexDigits(address)
+
+	return "0x" + addressHex[:4] + "..." + addressHex[len(addressHex)-4:], nil
+}
+
+// HasAddressPrefix reports whether the hexadecimal digits of an address start with the
+// given prefix, as needed by vanity address generators.
+//
+// When caseSensitive is true, the prefix is compared against the EIP-55 checksummed form
+// of the address, so "dEaD" only matches addresses whose checksum yields that exact case.
+// Otherwise the comparison ignores letter case.
+//
+// Parameters:
+//   - address: A string containing the Ethereum address, optionally prefixed with "0x".
+//   - prefix: The desired hexadecimal prefix, optionally prefixed with "0x".
+//   - caseSensitive: Whether the prefix must match the checksummed letter case.
+//
+// Returns:
+//   - bool: true if the address starts with the prefix, false otherwise.
+//   - error: An error if the address is invalid or the prefix is not hexadecimal.
+func HasAddressPrefix(address string, prefix string, caseSensitive bool) (bool, error) {
+	parsed, err := ParseAddress(address)
+	if err != nil {
+		return false, err
+	}
+
+	prefix = StripHexPrefix(prefix)
+	for _, c := range strings.ToLower(prefix) {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false, fmt.Errorf("%w: invalid prefix %q", ErrInvalidHex, prefix)
+		}
+	}
+
+	if !caseSensitive {
+		return strings.HasPrefix(hex.EncodeToString(parsed), strings.ToLower(prefix)), nil
+	}
+
+	checksumAddress, err := ToChecksumAddress(parsed)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.HasPrefix(checksumAddress[2:], prefix), nil
+}
+
 // Keccak computes the Keccak-256 hash of the input data.
 //
 // This function uses the Keccak-256 algorithm, which is the original version of
@@ -126,11 +583,199 @@ func Keccak(input []byte) []byte {
 	return hash
 }
 
+// NewKeccak256 returns a new streaming Keccak-256 hasher.
+//
+// The returned hash.Hash can be written to incrementally (e.g. with io.Copy) and
+// produces the same digest as Keccak once Sum is called, without requiring the whole
+// input to be held in memory.
+//
+// Returns:
+//
+//	A hash.Hash computing the legacy Keccak-256 digest.
+func NewKeccak256() hash.Hash {
+	return sha3.NewLegacyKeccak256()
+}
+
+// KeccakPacked computes the Keccak-256 hash of its arguments, each left-padded to 32 bytes,
+// matching Solidity's keccak256(abi.encode(a, b, ...)) for unsigned integers, addresses and
+// bytes32 values.
+//
+// Fixed-size bytes1 to bytes31 values are right-padded by abi.encode instead, so they must
+// be encoded with BytesNToWord first and passed as the resulting 32-byte word.
+//
+// Parameters:
+//   - args: A variadic parameter of byte slices, each at most 32 bytes long.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte Keccak-256 hash of the padded arguments.
+//   - error: An error if any argument is longer than 32 bytes.
+func KeccakPacked(args ...[]byte) ([]byte, error) {
+	words := make([][]byte, len(args))
+	for i, arg := range args {
+		word, err := PadTo32BytesStrict(arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument at index %d: %w", i, err)
+		}
+		words[i] = word
+	}
+
+	return Keccak(ConcatBytes(words...)), nil
+}
+
+// KeccakTightPacked computes the Keccak-256 hash of its arguments concatenated without
+// padding, matching Solidity's keccak256(abi.encodePacked(a, b, ...)).
+//
+// Parameters:
+//   - args: A variadic parameter of byte slices, already encoded in their packed widths.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte Keccak-256 hash of the concatenated arguments.
+func KeccakTightPacked(args ...[]byte) []byte {
+	return Keccak(ConcatBytes(args...))
+}
+
+// CommitHash computes the commitment of a commit-reveal scheme as keccak256(value || salt),
+// matching Solidity's keccak256(abi.encodePacked(value, salt)).
+//
+// Parameters:
+//   - value: A byte slice containing the committed value.
+//   - salt: A byte slice containing the secret salt that hides the value until it is revealed.
+//
+// Returns:
+//   - []byte: A byte slice containing the 32-byte commitment.
+func CommitHash(value []byte, salt []byte) []byte {
+	return Keccak(ConcatBytes(value, salt))
+}
+
+// VerifyCommit checks in constant time whether a revealed value and salt match a commitment.
+//
+// Parameters:
+//   - commit: A byte slice containing the 32-byte commitment, see CommitHash.
+//   - value: A byte slice containing the revealed value.
+//   - salt: A byte slice containing the revealed salt.
+//
+// Returns:
+//   - bool: true if keccak256(value || salt) equals the commitment, false otherwise.
+func VerifyCommit(commit, value, salt []byte) bool {
+	return subtle.ConstantTimeCompare(CommitHash(value, salt), commit) == 1
+}
+
+// KeccakModN deterministically selects an index in [0, n) from a seed, reproducing the
+// on-chain computation uint256(keccak256(seed)) % n.
+//
+// The hash is interpreted as a big-endian 256-bit integer. Because it is vastly larger
+// than any uint64 n, the modulo bias is below 2^-192 and negligible in practice.
+//
+// Parameters:
+//   - seed: A byte slice containing the seed, e.g. a block hash or a revealed secret.
+//   - n: The number of candidates to select from.
+//
+// Returns:
+//   - uint64: The selected index, or 0 if n is 0.
+func KeccakModN(seed []byte, n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+
+	value := new(big.Int).SetBytes(Keccak(seed))
+
+	return value.Mod(value, new(big.Int).SetUint64(n)).Uint64()
+}
+
+// Keccak512 computes the Keccak-512 hash of the input data.
+//
+// Like Keccak, this function uses the original (legacy) Keccak padding rather than
+// the standardized SHA3-512 variant.
+//
+// Parameters:
+//   - input: A byte slice containing the data to be hashed.
+//
+// Returns:
+//
+//	A byte slice containing the 64-byte (512-bit) Keccak-512 hash of the input data.
+func Keccak512(input []byte) []byte {
+	// Create a new Keccak-512 hasher
+	hasher := sha3.NewLegacyKeccak512()
+
+	// Write the input to the hasher
+	hasher.Write(input)
+
+	// Compute the hash digest
+	hash := hasher.Sum(nil)
+
+	return hash
+}
+
+// Blake2b256 computes the BLAKE2b-256 hash of the input data.
+//
+// BLAKE2b is used instead of Keccak by several non-Ethereum chains and off-chain systems.
+//
+// Parameters:
+//   - input: A byte slice containing the data to be hashed.
+//
+// Returns:
+//
+//	A byte slice containing the 32-byte (256-bit) BLAKE2b hash of the input data.
+func Blake2b256(input []byte) []byte {
+	hash := blake2b.Sum256(input)
+
+	return hash[:]
+}
+
+// SHA256 computes the SHA-256 hash of the input data.
+//
+// Parameters:
+//   - input: A byte slice containing the data to be hashed.
+//
+// Returns:
+//
+//	A byte slice containing the 32-byte SHA-256 hash of the input data.
+func SHA256(input []byte) []byte {
+	hash := sha256.Sum256(input)
+
+	return hash[:]
+}
+
+// DoubleSHA256 computes SHA-256(SHA-256(input)), the hash used by Bitcoin for block
+// hashes, transaction IDs and Base58Check checksums.
+//
+// Parameters:
+//   - input: A byte slice containing the data to be hashed.
+//
+// Returns:
+//
+//	A byte slice containing the 32-byte double SHA-256 hash of the input data.
+func DoubleSHA256(input []byte) []byte {
+	first := sha256.Sum256(input)
+	second := sha256.Sum256(first[:])
+
+	return second[:]
+}
+
+// Hash160 computes RIPEMD-160(SHA-256(input)), the hash used by Bitcoin-family chains
+// to derive addresses from public keys and scripts.
+//
+// Parameters:
+//   - input: A byte slice containing the data to be hashed.
+//
+// Returns:
+//
+//	A byte slice containing the 20-byte Hash160 of the input data.
+func Hash160(input []byte) []byte {
+	sha := sha256.Sum256(input)
+
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+
+	return hasher.Sum(nil)
+}
+
 // PadHexStringTo32Bytes converts a hexadecimal string to a byte slice and pads it to 32 bytes.
 //
 // This function takes a hexadecimal string (with or without '0x' prefix), converts it to bytes,
 // and then pads the result to ensure it's exactly 32 bytes long. The original data is right-justified
-// in the resulting byte slice, with any necessary zero padding added to the left.
+// in the resulting byte slice, with any necessary zero padding added to the left. Odd-length strings,
+// as returned for JSON-RPC quantities like "0x1", are accepted by left-padding a zero nibble.
 //
 // Parameters:
 //   - hexString: A string containing a hexadecimal representation of bytes, optionally prefixed with '0x'.
@@ -138,16 +783,24 @@ func Keccak(input []byte) []byte {
 // Returns:
 //   - []byte: A byte slice that is exactly 32 bytes long, containing the original data right-justified
 //     with zero padding on the left if necessary.
-//   - error: An error if the input string is not a valid hexadecimal representation.
+//   - error: An error if the input string is not a valid hexadecimal representation or
+//     decodes to more than 32 bytes.
 func PadHexStringTo32Bytes(hexString string) ([]byte, error) {
-	if strings.HasPrefix(hexString, "0x") {
-		hexString = hexString[2:]
+	hexString = StripHexPrefix(hexString)
+
+	// Left-pad a zero nibble for odd-length quantities such as "0x1"
+	if len(hexString)%2 != 0 {
+		hexString = "0" + hexString
 	}
 
 	// Decode hex string to byte slice
 	data, err := hex.DecodeString(hexString)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHex, err)
+	}
+
+	if len(data) > 32 {
+		return nil, fmt.Errorf("%w: got %d", ErrValueTooLarge, len(data))
 	}
 
 	// Ensure the slice is exactly 32 bytes long by left-padding with 0x00
@@ -171,15 +824,94 @@ func PadHexStringTo32Bytes(hexString string) ([]byte, error) {
 //   right-justified (at the end of the slice), with any necessary zero padding at the beginning.
 
 func PadTo32Bytes(sender []byte) []byte {
-	if len(sender) >= 32 {
-		return sender // Already 32 bytes long or longer
+	return PadLeft(sender, 32)
+}
+
+// PadTo32BytesStrict pads the input byte slice to 32 bytes, right-justifying the original data.
+//
+// Unlike PadTo32Bytes, inputs longer than 32 bytes are rejected instead of being
+// passed through unchanged, so the result is always a valid 32-byte ABI word.
+//
+// Parameters:
+//   - data: The input byte slice to be padded.
+//
+// Returns:
+//   - []byte: A byte slice that is exactly 32 bytes long, containing the original data
+//     right-justified with zero padding on the left if necessary.
+//   - error: An error if the input is longer than 32 bytes.
+func PadTo32BytesStrict(data []byte) ([]byte, error) {
+	if len(data) > 32 {
+		return nil, fmt.Errorf("%w: got %d", ErrValueTooLarge, len(data))
 	}
 
-	// Pad to 32 bytes (right-justify)
-	paddedSender := make([]byte, 32)
-	copy(paddedSender[32-len(sender):], sender)
+	return PadLeft(data, 32), nil
+}
 
-	return paddedSender
+// PadLeft pads the input byte slice with zero bytes on the left to the given size.
+//
+// If the input is already `size` bytes or longer, it is returned unchanged.
+//
+// Parameters:
+//   - data: The input byte slice to be padded.
+//   - size: The desired length of the resulting byte slice.
+//
+// Returns:
+//   - []byte: A new byte slice that is exactly `size` bytes long, containing the original data
+//     right-justified, or the original slice if it is already long enough.
+func PadLeft(data []byte, size int) []byte {
+	if len(data) >= size {
+		return data // Already long enough
+	}
+
+	// Pad to size (right-justify)
+	padded := make([]byte, size)
+	copy(padded[size-len(data):], data)
+
+	return padded
+}
+
+// TrimLeftZeros strips the leading zero bytes of the input byte slice.
+//
+// This is the inverse of PadLeft and yields the minimal big-endian encoding of a number,
+// as needed for JSON-RPC quantities. An input consisting only of zero bytes (or an empty
+// input) yields a single zero byte.
+//
+// Parameters:
+//   - b: The input byte slice to be trimmed.
+//
+// Returns:
+//   - []byte: A sub-slice of the input without leading zero bytes, or []byte{0}.
+func TrimLeftZeros(b []byte) []byte {
+	for i, c := range b {
+		if c != 0 {
+			return b[i:]
+		}
+	}
+
+	return []byte{0}
+}
+
+// PadRight pads the input byte slice with zero bytes on the right to the given size.
+//
+// If the input is already `size` bytes or longer, it is returned unchanged.
+//
+// Parameters:
+//   - data: The input byte slice to be padded.
+//   - size: The desired length of the resulting byte slice.
+//
+// Returns:
+//   - []byte: A new byte slice that is exactly `size` bytes long, containing the original data
+//     left-justified, or the original slice if it is already long enough.
+func PadRight(data []byte, size int) []byte {
+	if len(data) >= size {
+		return data // Already long enough
+	}
+
+	// Pad to size (left-justify)
+	padded := make([]byte, size)
+	copy(padded, data)
+
+	return padded
 }
 
 // ConcatBytes concatenates multiple byte slices into a single byte slice.
@@ -190,14 +922,123 @@ func PadTo32Bytes(sender []byte) []byte {
 // Returns:
 //   - []byte: A new byte slice containing all input byte slices concatenated in the order they were provided.
 func ConcatBytes(bytes ...[]byte) []byte {
+	result := make([]byte, 0, TotalLen(bytes...))
+	for _, b := range bytes {
+		result = append(result, b...)
+	}
+
+	return result
+}
+
+// ConcatBytesN concatenates multiple byte slices into a single byte slice and also
+// returns the length of the result.
+//
+// Parameters:
+//   - bytes: A variadic parameter of type []byte, representing the byte slices to be concatenated.
+//
+// Returns:
+//   - []byte: A new byte slice containing all input byte slices concatenated in the order they were provided.
+//   - int: The length of the concatenated byte slice.
+func ConcatBytesN(bytes ...[]byte) ([]byte, int) {
+	result := ConcatBytes(bytes...)
+
+	return result, len(result)
+}
+
+// TotalLen computes the combined length of multiple byte slices, i.e. the length of
+// their concatenation, which can be used to preallocate buffers.
+//
+// Parameters:
+//   - bytes: A variadic parameter of type []byte, representing the byte slices to be measured.
+//
+// Returns:
+//   - int: The sum of the lengths of all input byte slices.
+func TotalLen(bytes ...[]byte) int {
 	size := 0
 	for _, b := range bytes {
 		size += len(b)
 	}
-	result := make([]byte, 0, size)
-	for _, b := range bytes {
-		result = append(result, b...)
+
+	return size
+}
+
+// XorBytes computes the elementwise XOR of two byte slices of equal length.
+//
+// Parameters:
+//   - a: The first byte slice.
+//   - b: The second byte slice, which must have the same length as a.
+//
+// Returns:
+//   - []byte: A new byte slice where each byte is a[i] ^ b[i].
+//   - error: An error if the byte slices have different lengths.
+func XorBytes(a, b []byte) ([]byte, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("length mismatch: %d and %d bytes", len(a), len(b))
 	}
 
-	return result
+	result := make([]byte, len(a))
+	for i := range a {
+		result[i] = a[i] ^ b[i]
+	}
+
+	return result, nil
+}
+
+// ReverseBytes returns a copy of the input byte slice with the order of its bytes reversed,
+// e.g. to convert between big-endian and little-endian representations.
+//
+// Parameters:
+//   - b: The input byte slice.
+//
+// Returns:
+//   - []byte: A new byte slice containing the bytes of b in reverse order.
+func ReverseBytes(b []byte) []byte {
+	reversed := make([]byte, len(b))
+	for i, c := range b {
+		reversed[len(b)-1-i] = c
+	}
+
+	return reversed
+}
+
+// Uint64ToBytesBE encodes an unsigned integer as 8 big-endian bytes.
+func Uint64ToBytesBE(n uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, n)
+}
+
+// Uint64ToBytesLE encodes an unsigned integer as 8 little-endian bytes.
+func Uint64ToBytesLE(n uint64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, n)
+}
+
+// BytesToUint64BE decodes up to 8 big-endian bytes into an unsigned integer.
+//
+// Parameters:
+//   - b: A byte slice of at most 8 bytes; shorter inputs are treated as left-padded with zeros.
+//
+// Returns:
+//   - uint64: The decoded value.
+//   - error: An error if the input is longer than 8 bytes.
+func BytesToUint64BE(b []byte) (uint64, error) {
+	if len(b) > 8 {
+		return 0, fmt.Errorf("value exceeds 8 bytes: got %d", len(b))
+	}
+
+	return binary.BigEndian.Uint64(PadLeft(b, 8)), nil
+}
+
+// BytesToUint64LE decodes up to 8 little-endian bytes into an unsigned integer.
+//
+// Parameters:
+//   - b: A byte slice of at most 8 bytes; shorter inputs are treated as right-padded with zeros.
+//
+// Returns:
+//   - uint64: The decoded value.
+//   - error: An error if the input is longer than 8 bytes.
+func BytesToUint64LE(b []byte) (uint64, error) {
+	if len(b) > 8 {
+		return 0, fmt.Errorf("value exceeds 8 bytes: got %d", len(b))
+	}
+
+	return binary.LittleEndian.Uint64(PadRight(b, 8)), nil
 }